@@ -3,6 +3,7 @@ package address
 import (
 	"encoding/hex"
 	"errors"
+	"strconv"
 	"strings"
 
 	"golang.org/x/crypto/sha3"
@@ -34,9 +35,44 @@ func EIP55Checksum(unchecksummed string) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	hash := sha.Sum(nil)
 
-	result := v
+	return applyChecksum(v, sha.Sum(nil))
+}
+
+// EIP1191Checksum returns an EIP1191-compliant hex string representation of the address.
+// Unlike EIP55 the chain ID is part of the hashed data, so the result differs between networks.
+func EIP1191Checksum(unchecksummed string, chainID uint) (string, error) {
+	v := []byte(Remove0x(strings.ToLower(unchecksummed)))
+
+	_, err := hex.DecodeString(string(v))
+	if err != nil {
+		return "", err
+	}
+
+	sha := sha3.NewLegacyKeccak256()
+	_, err = sha.Write([]byte(strconv.FormatUint(uint64(chainID), 10) + hexPrefix))
+	if err != nil {
+		return "", err
+	}
+	_, err = sha.Write(v)
+	if err != nil {
+		return "", err
+	}
+
+	return applyChecksum(v, sha.Sum(nil))
+}
+
+// ChecksumByCoin returns the address checksummed with the scheme used by the coin:
+// EIP1191 when the coin has the EIP1191 flag and a chain ID, EIP55 otherwise.
+func ChecksumByCoin(c coin.Coin, unchecksummed string) (string, error) {
+	if c.EIP1191 && c.ChainID != nil {
+		return EIP1191Checksum(unchecksummed, *c.ChainID)
+	}
+	return EIP55Checksum(unchecksummed)
+}
+
+// applyChecksum upper-cases the hex letters of the lower-case address whose hash nibble is above 7.
+func applyChecksum(result, hash []byte) (string, error) {
 	if (len(result)-1)/2 >= len(hash) {
 		return "", ErrInvalidInput
 	}
@@ -56,25 +92,20 @@ func EIP55Checksum(unchecksummed string) (string, error) {
 	return "0x" + val, nil
 }
 
-func ToEIP55ByCoinID(str string, coinID uint) (eip55Addr string, err error) {
+// ToEIP55ByCoinID checksums an EVM address for the coin, returning the same 0x form as Normalize.
+// Ronin addresses with the ronin: prefix are converted to 0x. Addresses of non-EVM coins are returned unchanged.
+func ToEIP55ByCoinID(str string, coinID uint) (string, error) {
 	if !coin.IsEVM(coinID) {
 		return str, nil
 	}
 
-	// special case for ronin addresses
-	const roninPrefix, hexPrefix = "ronin:", "0x"
 	if coinID == coin.RONIN && strings.HasPrefix(str, roninPrefix) {
 		str = hexPrefix + str[len(roninPrefix):]
-		defer func() {
-			// remove 0x prefix, then add roninPrefix
-			eip55Addr = roninPrefix + eip55Addr[len(hexPrefix):]
-		}()
 	}
 
-	eip55Addr, err = EIP55Checksum(str)
+	eip55Addr, err := ChecksumByCoin(coin.Coins[coinID], str)
 	if err != nil {
 		return "", err
 	}
-
-	return
+	return eip55Addr, nil
 }
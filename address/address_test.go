@@ -71,7 +71,7 @@ func TestToEIP55ByCoinID(t *testing.T) {
 		wanAddrEIP55ChecksumWanchain = "0xAe96137E0e05681eD2F5D1AF272C3ee512939D0F"
 
 		roninAddr      = "ronin:ea674fdde714fd979de3edf0f56aa9716b898ec8"
-		roninAddrEIP55 = "ronin:EA674fdDe714fd979de3EdF0F56AA9716B898ec8"
+		roninAddrEIP55 = "0xEA674fdDe714fd979de3EdF0F56AA9716B898ec8"
 
 		tests = []struct {
 			name, address, expectedAddress string
@@ -91,6 +91,7 @@ func TestToEIP55ByCoinID(t *testing.T) {
 			{"Non Ethereum like chain 2", addr1, addr1, coin.BINANCE},
 			{"SmartChain", addr1, addr1EIP55, coin.SMARTCHAIN},
 			{"Ronin", roninAddr, roninAddrEIP55, coin.RONIN},
+			{"Ronin hex", addr1, roninAddrEIP55, coin.RONIN},
 		}
 	)
 
@@ -101,3 +102,62 @@ func TestToEIP55ByCoinID(t *testing.T) {
 		}
 	})
 }
+
+func TestEIP1191Checksum(t *testing.T) {
+	tests := []struct {
+		name    string
+		address string
+		chainID uint
+		want    string
+	}{
+		{"RSK mainnet 1", "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", 30, "0x5aaEB6053f3e94c9b9a09f33669435E7ef1bEAeD"},
+		{"RSK mainnet 2", "0xFB6916095CA1DF60BB79CE92CE3EA74C37C5D359", 30, "0xFb6916095cA1Df60bb79ce92cE3EA74c37c5d359"},
+		{"RSK mainnet 3", "dbf03b407c01e7cd3cbea99509d93f8dddc8c6fb", 30, "0xDBF03B407c01E7CD3cBea99509D93F8Dddc8C6FB"},
+		{"RSK testnet 1", "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", 31, "0x5aAeb6053F3e94c9b9A09F33669435E7EF1BEaEd"},
+		{"RSK testnet 2", "0xd1220a0cf47c7b9be7a2e6ba89f429762e7b9adb", 31, "0xd1220a0CF47c7B9Be7A2E6Ba89f429762E7b9adB"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EIP1191Checksum(tt.address, tt.chainID)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := EIP1191Checksum("0xzz", 30)
+	assert.Error(t, err)
+}
+
+func TestChecksumByCoin(t *testing.T) {
+	rsk := coin.Rootstock()
+	noChainID := coin.Coin{ID: 137, Handle: "rootstock", Blockchain: coin.BlockchainEthereum, EIP1191: true}
+
+	const addr = "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"
+	tests := []struct {
+		name string
+		coin coin.Coin
+		want string
+	}{
+		{"EIP55", coin.Ethereum(), "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"},
+		{"EIP1191", rsk, "0x5aaEB6053f3e94c9b9a09f33669435E7ef1bEAeD"},
+		{"EIP1191 without chain ID", noChainID, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ChecksumByCoin(tt.coin, addr)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+
+			assert.NoError(t, validateEVMHex(tt.coin, got))
+		})
+	}
+
+	assert.ErrorIs(t, validateEVMHex(rsk, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"), ErrInvalidChecksum)
+
+	assert.True(t, rsk.EIP1191)
+	normalized, err := Normalize(coin.ROOTSTOCK, addr)
+	assert.NoError(t, err)
+	assert.Equal(t, "0x5aaEB6053f3e94c9b9a09f33669435E7ef1bEAeD", normalized)
+	assert.NoError(t, Validate(coin.ROOTSTOCK, normalized))
+	assert.Error(t, Validate(coin.ROOTSTOCK, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"), "EIP55 checksum is not valid on Rootstock")
+}
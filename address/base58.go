@@ -0,0 +1,137 @@
+package address
+
+import (
+	"crypto/sha256"
+	"errors"
+	"math/big"
+)
+
+const (
+	bitcoinAlphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	rippleAlphabet  = "rpshnaf39wBUDNEGHJKLM4PQRST7VWXYZ2bcdeCg65jkm8oFqi1tuvAxyz"
+)
+
+var (
+	ErrInvalidBase58   = errors.New("invalid base58 string")
+	ErrInvalidChecksum = errors.New("invalid checksum")
+)
+
+var (
+	bitcoinBase58 = newBase58Encoding(bitcoinAlphabet)
+	rippleBase58  = newBase58Encoding(rippleAlphabet)
+
+	bigRadix = big.NewInt(58)
+)
+
+type base58Encoding struct {
+	alphabet string
+	decode   [256]int8
+}
+
+func newBase58Encoding(alphabet string) *base58Encoding {
+	enc := &base58Encoding{alphabet: alphabet}
+	for i := range enc.decode {
+		enc.decode[i] = -1
+	}
+	for i := 0; i < len(alphabet); i++ {
+		enc.decode[alphabet[i]] = int8(i)
+	}
+	return enc
+}
+
+func (enc *base58Encoding) encode(input []byte) string {
+	x := new(big.Int).SetBytes(input)
+	mod := new(big.Int)
+
+	result := make([]byte, 0, len(input)*138/100+1)
+	for x.Sign() > 0 {
+		x.DivMod(x, bigRadix, mod)
+		result = append(result, enc.alphabet[mod.Int64()])
+	}
+	for _, b := range input {
+		if b != 0 {
+			break
+		}
+		result = append(result, enc.alphabet[0])
+	}
+
+	for i, j := 0, len(result)-1; i < j; i, j = i+1, j-1 {
+		result[i], result[j] = result[j], result[i]
+	}
+	return string(result)
+}
+
+func (enc *base58Encoding) decodeString(input string) ([]byte, error) {
+	if len(input) == 0 {
+		return nil, ErrInvalidBase58
+	}
+
+	x := new(big.Int)
+	for i := 0; i < len(input); i++ {
+		digit := enc.decode[input[i]]
+		if digit < 0 {
+			return nil, ErrInvalidBase58
+		}
+		x.Mul(x, bigRadix)
+		x.Add(x, big.NewInt(int64(digit)))
+	}
+
+	var zeros int
+	for zeros < len(input) && input[zeros] == enc.alphabet[0] {
+		zeros++
+	}
+
+	decoded := x.Bytes()
+	result := make([]byte, zeros+len(decoded))
+	copy(result[zeros:], decoded)
+	return result, nil
+}
+
+// Base58Encode encodes the input using the Bitcoin base58 alphabet.
+func Base58Encode(input []byte) string {
+	return bitcoinBase58.encode(input)
+}
+
+// Base58Decode decodes a string encoded with the Bitcoin base58 alphabet.
+func Base58Decode(input string) ([]byte, error) {
+	return bitcoinBase58.decodeString(input)
+}
+
+// Base58CheckEncode appends a double SHA-256 checksum to the payload and encodes it.
+func Base58CheckEncode(payload []byte) string {
+	return bitcoinBase58.encode(appendChecksum(payload))
+}
+
+// Base58CheckDecode decodes the input and verifies its double SHA-256 checksum.
+// The returned payload does not include the checksum.
+func Base58CheckDecode(input string) ([]byte, error) {
+	return bitcoinBase58.decodeCheck(input)
+}
+
+func (enc *base58Encoding) decodeCheck(input string) ([]byte, error) {
+	decoded, err := enc.decodeString(input)
+	if err != nil {
+		return nil, err
+	}
+	if len(decoded) < 4 {
+		return nil, ErrInvalidLength
+	}
+
+	payload := decoded[:len(decoded)-4]
+	if string(doubleSHA256(payload)[:4]) != string(decoded[len(decoded)-4:]) {
+		return nil, ErrInvalidChecksum
+	}
+	return payload, nil
+}
+
+func appendChecksum(payload []byte) []byte {
+	result := make([]byte, 0, len(payload)+4)
+	result = append(result, payload...)
+	return append(result, doubleSHA256(payload)[:4]...)
+}
+
+func doubleSHA256(data []byte) []byte {
+	first := sha256.Sum256(data)
+	second := sha256.Sum256(first[:])
+	return second[:]
+}
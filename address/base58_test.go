@@ -0,0 +1,47 @@
+package address
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBase58(t *testing.T) {
+	tests := []struct {
+		name    string
+		hex     string
+		encoded string
+	}{
+		{"empty leading zeros", "00", "1"},
+		{"hello world", "68656c6c6f20776f726c64", "StV1DL6CwTryKyV"},
+		{"leading zeros", "00000000000000000000", "1111111111"},
+		{"bitcoin genesis", "0062e907b15cbf27d5425399ebf6f0fb50ebb88f18c29b7d93", "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input, _ := hex.DecodeString(tt.hex)
+			assert.Equal(t, tt.encoded, Base58Encode(input))
+
+			decoded, err := Base58Decode(tt.encoded)
+			assert.NoError(t, err)
+			assert.Equal(t, input, decoded)
+		})
+	}
+
+	_, err := Base58Decode("0OIl")
+	assert.ErrorIs(t, err, ErrInvalidBase58)
+}
+
+func TestBase58Check(t *testing.T) {
+	payload, err := Base58CheckDecode("1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa")
+	assert.NoError(t, err)
+	assert.Equal(t, "0062e907b15cbf27d5425399ebf6f0fb50ebb88f18", hex.EncodeToString(payload))
+	assert.Equal(t, "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa", Base58CheckEncode(payload))
+
+	_, err = Base58CheckDecode("1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNb")
+	assert.ErrorIs(t, err, ErrInvalidChecksum)
+
+	_, err = Base58CheckDecode("1A1")
+	assert.ErrorIs(t, err, ErrInvalidLength)
+}
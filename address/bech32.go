@@ -0,0 +1,207 @@
+package address
+
+import (
+	"errors"
+	"strings"
+)
+
+const (
+	bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+	bech32Const  = 1
+	bech32mConst = 0x2bc830a3
+
+	// bech32MaxLength is the limit from BIP-173, Cardano addresses are longer
+	// and use bech32LongMaxLength instead.
+	bech32MaxLength     = 90
+	bech32LongMaxLength = 1023
+)
+
+var ErrInvalidBech32 = errors.New("invalid bech32 string")
+
+// Bech32Variant is the checksum constant used by a bech32 string, BIP-173 or BIP-350.
+type Bech32Variant int
+
+const (
+	Bech32 Bech32Variant = iota + 1
+	Bech32m
+)
+
+var bech32Generator = [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+
+func bech32Polymod(values []byte) uint32 {
+	chk := uint32(1)
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := 0; i < 5; i++ {
+			if (top>>uint(i))&1 == 1 {
+				chk ^= bech32Generator[i]
+			}
+		}
+	}
+	return chk
+}
+
+func bech32HRPExpand(hrp string) []byte {
+	result := make([]byte, 0, len(hrp)*2+1)
+	for i := 0; i < len(hrp); i++ {
+		result = append(result, hrp[i]>>5)
+	}
+	result = append(result, 0)
+	for i := 0; i < len(hrp); i++ {
+		result = append(result, hrp[i]&31)
+	}
+	return result
+}
+
+// decodeBech32 splits a bech32 or bech32m string into its human-readable part and
+// 5-bit data words, verifying the checksum.
+func decodeBech32(input string, maxLength int) (string, []byte, Bech32Variant, error) {
+	if len(input) < 8 || len(input) > maxLength {
+		return "", nil, 0, ErrInvalidLength
+	}
+	if strings.ToLower(input) != input && strings.ToUpper(input) != input {
+		return "", nil, 0, ErrInvalidBech32
+	}
+	input = strings.ToLower(input)
+
+	sep := strings.LastIndexByte(input, '1')
+	if sep < 1 || sep+7 > len(input) {
+		return "", nil, 0, ErrInvalidBech32
+	}
+
+	hrp := input[:sep]
+	for i := 0; i < len(hrp); i++ {
+		if hrp[i] < 33 || hrp[i] > 126 {
+			return "", nil, 0, ErrInvalidBech32
+		}
+	}
+
+	data := make([]byte, 0, len(input)-sep-1)
+	for i := sep + 1; i < len(input); i++ {
+		d := strings.IndexByte(bech32Charset, input[i])
+		if d < 0 {
+			return "", nil, 0, ErrInvalidBech32
+		}
+		data = append(data, byte(d))
+	}
+
+	var variant Bech32Variant
+	switch bech32Polymod(append(bech32HRPExpand(hrp), data...)) {
+	case bech32Const:
+		variant = Bech32
+	case bech32mConst:
+		variant = Bech32m
+	default:
+		return "", nil, 0, ErrInvalidChecksum
+	}
+
+	return hrp, data[:len(data)-6], variant, nil
+}
+
+// Bech32Encode encodes data under the human-readable part hrp.
+func Bech32Encode(hrp string, data []byte, variant Bech32Variant) (string, error) {
+	var checksumConst uint32
+	switch variant {
+	case Bech32:
+		checksumConst = bech32Const
+	case Bech32m:
+		checksumConst = bech32mConst
+	default:
+		return "", ErrInvalidBech32
+	}
+	if len(hrp) == 0 || strings.ToLower(hrp) != hrp {
+		return "", ErrInvalidHRP
+	}
+
+	words, err := convertBits(data, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+
+	values := append(bech32HRPExpand(hrp), words...)
+	polymod := bech32Polymod(append(values, 0, 0, 0, 0, 0, 0)) ^ checksumConst
+	for i := 0; i < 6; i++ {
+		words = append(words, byte(polymod>>uint(5*(5-i))&31))
+	}
+
+	var sb strings.Builder
+	sb.Grow(len(hrp) + 1 + len(words))
+	sb.WriteString(hrp)
+	sb.WriteByte('1')
+	for _, w := range words {
+		sb.WriteByte(bech32Charset[w])
+	}
+	if sb.Len() > bech32LongMaxLength {
+		return "", ErrInvalidLength
+	}
+	return sb.String(), nil
+}
+
+// Bech32Decode decodes a bech32 or bech32m string and returns its lower-case
+// human-readable part, data and variant.
+func Bech32Decode(input string) (string, []byte, Bech32Variant, error) {
+	hrp, words, variant, err := decodeBech32(input, bech32LongMaxLength)
+	if err != nil {
+		return "", nil, 0, err
+	}
+	data, err := convertBits(words, 5, 8, false)
+	if err != nil {
+		return "", nil, 0, err
+	}
+	return hrp, data, variant, nil
+}
+
+// HRP returns the bech32 human-readable part of the coin accounts.
+func HRP(coinID uint) (string, error) {
+	hrp, ok := bech32HRPs[coinID]
+	if !ok {
+		return "", ErrUnsupportedCoin
+	}
+	return hrp, nil
+}
+
+// ConvertHRP re-encodes a bech32 account address under the human-readable part of
+// the given coin, e.g. cosmos1... to osmo1... for the same account bytes.
+func ConvertHRP(addr string, coinID uint) (string, error) {
+	hrp, err := HRP(coinID)
+	if err != nil {
+		return "", err
+	}
+	_, data, variant, err := Bech32Decode(addr)
+	if err != nil {
+		return "", err
+	}
+	return Bech32Encode(hrp, data, variant)
+}
+
+// convertBits regroups a slice of fromBits-wide words into toBits-wide words.
+func convertBits(data []byte, fromBits, toBits uint, pad bool) ([]byte, error) {
+	var (
+		acc    uint32
+		bits   uint
+		result = make([]byte, 0, len(data)*int(fromBits)/int(toBits)+1)
+		maxV   = uint32(1)<<toBits - 1
+	)
+	for _, v := range data {
+		if uint32(v)>>fromBits != 0 {
+			return nil, ErrInvalidBech32
+		}
+		acc = acc<<fromBits | uint32(v)
+		bits += fromBits
+		for bits >= toBits {
+			bits -= toBits
+			result = append(result, byte(acc>>bits&maxV))
+		}
+	}
+
+	if pad {
+		if bits > 0 {
+			result = append(result, byte(acc<<(toBits-bits)&maxV))
+		}
+	} else if bits >= fromBits || acc<<(toBits-bits)&maxV != 0 {
+		return nil, ErrInvalidBech32
+	}
+	return result, nil
+}
@@ -0,0 +1,112 @@
+package address
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/trustwallet/go-primitives/coin"
+)
+
+func TestDecodeBech32(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		wantHRP     string
+		wantVariant Bech32Variant
+		wantErr     error
+	}{
+		{"bech32", "A12UEL5L", "a", Bech32, nil},
+		{"bech32 long hrp", "an83characterlonghumanreadablepartthatcontainsthenumber1andtheexcludedcharactersbio1tt5tgs",
+			"an83characterlonghumanreadablepartthatcontainsthenumber1andtheexcludedcharactersbio", Bech32, nil},
+		{"bech32m", "A1LQFN3A", "a", Bech32m, nil},
+		{"bech32m abcdef", "abcdef1l7aum6echk45nj3s0wdvt2fg8x9yrzpqzd3ryx", "abcdef", Bech32m, nil},
+		{"empty hrp", "1qzzfhee", "", 0, ErrInvalidBech32},
+		{"mixed case", "A12UEl5L", "", 0, ErrInvalidBech32},
+		{"invalid character", "a12uel5b", "", 0, ErrInvalidBech32},
+		{"short checksum", "a1q5nq", "", 0, ErrInvalidLength},
+		{"bad checksum", "a12uel5m", "", 0, ErrInvalidChecksum},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hrp, _, variant, err := decodeBech32(tt.input, bech32MaxLength)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantHRP, hrp)
+			assert.Equal(t, tt.wantVariant, variant)
+		})
+	}
+}
+
+func TestConvertBits(t *testing.T) {
+	words, err := convertBits([]byte{0xff, 0x00}, 8, 5, true)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{31, 28, 0, 0}, words)
+
+	bytes, err := convertBits(words, 5, 8, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0xff, 0x00}, bytes)
+
+	_, err = convertBits([]byte{32}, 5, 8, false)
+	assert.ErrorIs(t, err, ErrInvalidBech32)
+}
+
+func TestBech32EncodeDecode(t *testing.T) {
+	const cosmosAddr = "cosmos1hsk6jryyqjfhp5dhc55tc9jtckygx0eph6dd02"
+
+	hrp, data, variant, err := Bech32Decode(cosmosAddr)
+	assert.NoError(t, err)
+	assert.Equal(t, "cosmos", hrp)
+	assert.Equal(t, Bech32, variant)
+	assert.Len(t, data, 20)
+
+	encoded, err := Bech32Encode(hrp, data, variant)
+	assert.NoError(t, err)
+	assert.Equal(t, cosmosAddr, encoded)
+
+	encoded, err = Bech32Encode("bc", data, Bech32m)
+	assert.NoError(t, err)
+	_, _, variant, err = Bech32Decode(encoded)
+	assert.NoError(t, err)
+	assert.Equal(t, Bech32m, variant)
+
+	_, err = Bech32Encode("Cosmos", data, Bech32)
+	assert.ErrorIs(t, err, ErrInvalidHRP)
+
+	_, err = Bech32Encode("cosmos", data, 0)
+	assert.ErrorIs(t, err, ErrInvalidBech32)
+}
+
+func TestConvertHRP(t *testing.T) {
+	tests := []struct {
+		name    string
+		addr    string
+		coinID  uint
+		want    string
+		wantErr error
+	}{
+		{"cosmos to osmosis", "cosmos1hsk6jryyqjfhp5dhc55tc9jtckygx0eph6dd02", coin.OSMOSIS, "osmo1hsk6jryyqjfhp5dhc55tc9jtckygx0eplp7aec", nil},
+		{"osmosis to cosmos", "osmo1hsk6jryyqjfhp5dhc55tc9jtckygx0eplp7aec", coin.COSMOS, "cosmos1hsk6jryyqjfhp5dhc55tc9jtckygx0eph6dd02", nil},
+		{"cosmos to celestia", "cosmos1hsk6jryyqjfhp5dhc55tc9jtckygx0eph6dd02", coin.TIA, "celestia1hsk6jryyqjfhp5dhc55tc9jtckygx0epxsua48", nil},
+		{"non bech32 coin", "cosmos1hsk6jryyqjfhp5dhc55tc9jtckygx0eph6dd02", coin.ETHEREUM, "", ErrUnsupportedCoin},
+		{"bad checksum", "cosmos1hsk6jryyqjfhp5dhc55tc9jtckygx0eph6dd03", coin.OSMOSIS, "", ErrInvalidChecksum},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ConvertHRP(tt.addr, tt.coinID)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestConvertHRPCosmosCoins checks that every Cosmos coin has a human-readable part
+func TestConvertHRPCosmosCoins(t *testing.T) {
+	for _, c := range coin.Coins {
+		if c.Blockchain != coin.BlockchainCosmos {
+			continue
+		}
+		converted, err := ConvertHRP("cosmos1hsk6jryyqjfhp5dhc55tc9jtckygx0eph6dd02", c.ID)
+		assert.NoErrorf(t, err, "chain: %s", c.Handle)
+		assert.NoErrorf(t, Validate(c.ID, converted), "chain: %s", c.Handle)
+	}
+}
@@ -0,0 +1,59 @@
+package address
+
+import "strings"
+
+var cashAddrGenerator = [5]uint64{0x98f2bc8e61, 0x79b76d99e2, 0xf33e5fb3c4, 0xae2eabe2a8, 0x1e4f43e470}
+
+func cashAddrPolymod(values []byte) uint64 {
+	c := uint64(1)
+	for _, d := range values {
+		c0 := byte(c >> 35)
+		c = (c&0x07ffffffff)<<5 ^ uint64(d)
+		for i := 0; i < 5; i++ {
+			if (c0>>uint(i))&1 == 1 {
+				c ^= cashAddrGenerator[i]
+			}
+		}
+	}
+	return c ^ 1
+}
+
+// decodeCashAddr decodes a Bitcoin Cash address with or without its prefix
+// and returns the version byte followed by the hash.
+func decodeCashAddr(input, prefix string) ([]byte, error) {
+	if strings.ToLower(input) != input && strings.ToUpper(input) != input {
+		return nil, ErrInvalidBech32
+	}
+	input = strings.ToLower(input)
+
+	if sep := strings.IndexByte(input, ':'); sep >= 0 {
+		if input[:sep] != prefix {
+			return nil, ErrInvalidPrefix
+		}
+		input = input[sep+1:]
+	}
+	if len(input) < 8 {
+		return nil, ErrInvalidLength
+	}
+
+	values := make([]byte, 0, len(prefix)+1+len(input))
+	for i := 0; i < len(prefix); i++ {
+		values = append(values, prefix[i]&31)
+	}
+	values = append(values, 0)
+
+	data := make([]byte, 0, len(input))
+	for i := 0; i < len(input); i++ {
+		d := strings.IndexByte(bech32Charset, input[i])
+		if d < 0 {
+			return nil, ErrInvalidBech32
+		}
+		data = append(data, byte(d))
+	}
+
+	if cashAddrPolymod(append(values, data...)) != 0 {
+		return nil, ErrInvalidChecksum
+	}
+
+	return convertBits(data[:len(data)-8], 5, 8, false)
+}
@@ -0,0 +1,48 @@
+package address
+
+import (
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/ripemd160" // nolint:staticcheck
+	"golang.org/x/crypto/sha3"
+)
+
+// crc16XModem is the CRC-16/XMODEM checksum used by Stellar and TON addresses.
+func crc16XModem(data []byte) uint16 {
+	var crc uint16
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+func keccak256(data ...[]byte) []byte {
+	sha := sha3.NewLegacyKeccak256()
+	for _, d := range data {
+		_, _ = sha.Write(d)
+	}
+	return sha.Sum(nil)
+}
+
+func blake2bSum(size int, data ...[]byte) []byte {
+	h, err := blake2b.New(size, nil)
+	if err != nil {
+		panic(err)
+	}
+	for _, d := range data {
+		_, _ = h.Write(d)
+	}
+	return h.Sum(nil)
+}
+
+func ripemd160Sum(data []byte) []byte {
+	h := ripemd160.New()
+	_, _ = h.Write(data)
+	return h.Sum(nil)
+}
@@ -0,0 +1,66 @@
+package address
+
+import (
+	"encoding/hex"
+	"math/big"
+)
+
+// ContractAddress returns the address of a contract deployed with CREATE by deployer
+// at the given nonce: keccak256(rlp([deployer, nonce]))[12:].
+func ContractAddress(deployer string, nonce uint64) (string, error) {
+	sender, err := decodeHexBytes(deployer, 20)
+	if err != nil {
+		return "", err
+	}
+
+	// rlp encoded [sender, nonce], the payload is always shorter than 56 bytes
+	payload := make([]byte, 0, 30)
+	payload = append(payload, 0x80+byte(len(sender)))
+	payload = append(payload, sender...)
+	switch {
+	case nonce == 0:
+		payload = append(payload, 0x80)
+	case nonce < 0x80:
+		payload = append(payload, byte(nonce))
+	default:
+		n := new(big.Int).SetUint64(nonce).Bytes()
+		payload = append(payload, 0x80+byte(len(n)))
+		payload = append(payload, n...)
+	}
+
+	hash := keccak256([]byte{0xc0 + byte(len(payload))}, payload)
+	return EIP55Checksum(hex.EncodeToString(hash[12:]))
+}
+
+// Create2Address returns the address of a contract deployed with CREATE2 by deployer:
+// keccak256(0xff ++ deployer ++ salt ++ initCodeHash)[12:].
+// salt and initCodeHash are 32-byte hex strings.
+func Create2Address(deployer, salt, initCodeHash string) (string, error) {
+	sender, err := decodeHexBytes(deployer, 20)
+	if err != nil {
+		return "", err
+	}
+	saltBytes, err := decodeHexBytes(salt, 32)
+	if err != nil {
+		return "", err
+	}
+	codeHash, err := decodeHexBytes(initCodeHash, 32)
+	if err != nil {
+		return "", err
+	}
+
+	hash := keccak256([]byte{0xff}, sender, saltBytes, codeHash)
+	return EIP55Checksum(hex.EncodeToString(hash[12:]))
+}
+
+// decodeHexBytes decodes an optionally 0x-prefixed hex string of exactly size bytes.
+func decodeHexBytes(input string, size int) ([]byte, error) {
+	decoded, err := hex.DecodeString(Remove0x(input))
+	if err != nil {
+		return nil, ErrInvalidCharacter
+	}
+	if len(decoded) != size {
+		return nil, ErrInvalidLength
+	}
+	return decoded, nil
+}
@@ -0,0 +1,68 @@
+package address
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/trustwallet/go-primitives/coin"
+)
+
+func TestContractAddress(t *testing.T) {
+	tests := []struct {
+		name     string
+		deployer string
+		nonce    uint64
+		want     string
+		wantErr  error
+	}{
+		{"nonce 0", "0x6ac7ea33f8831ea9dcc53393aaa88b25a785dbf0", 0, "0xcd234A471b72ba2F1Ccf0A70FCABA648a5eeCD8d", nil},
+		{"nonce 1", "0x6ac7ea33f8831ea9dcc53393aaa88b25a785dbf0", 1, "0x343c43A37D37dfF08AE8C4A11544c718AbB4fCF8", nil},
+		{"nonce 2", "0x6ac7ea33f8831ea9dcc53393aaa88b25a785dbf0", 2, "0xf778B86FA74E846c4f0a1fBd1335FE81c00a0C91", nil},
+		{"nonce 3", "6ac7ea33f8831ea9dcc53393aaa88b25a785dbf0", 3, "0xffFd933A0bC612844eaF0C6Fe3E5b8E9B6C1d19c", nil},
+		{"short deployer", "0x6ac7ea33f8831ea9dcc53393aaa88b25a785db", 0, "", ErrInvalidLength},
+		{"bad deployer", "0x6ac7ea33f8831ea9dcc53393aaa88b25a785dbzz", 0, "", ErrInvalidCharacter},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ContractAddress(tt.deployer, tt.nonce)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	// large nonces use a multi-byte RLP string and must still produce a valid address
+	got, err := ContractAddress("0x6ac7ea33f8831ea9dcc53393aaa88b25a785dbf0", 1<<40)
+	assert.NoError(t, err)
+	assert.NoError(t, validateEVMHex(coin.Ethereum(), got))
+}
+
+// TestCreate2Address uses the examples from EIP-1014
+func TestCreate2Address(t *testing.T) {
+	const zeroSalt = "0x0000000000000000000000000000000000000000000000000000000000000000"
+	tests := []struct {
+		name     string
+		deployer string
+		salt     string
+		initCode string
+		want     string
+		wantErr  error
+	}{
+		{"example 0", "0x0000000000000000000000000000000000000000", zeroSalt, "00", "0x4D1A2e2bB4F88F0250f26Ffff098B0b30B26BF38", nil},
+		{"example 1", "0xdeadbeef00000000000000000000000000000000", zeroSalt, "00", "0xB928f69Bb1D91Cd65274e3c79d8986362984fDA3", nil},
+		{"example 2", "0xdeadbeef00000000000000000000000000000000", "0x000000000000000000000000feed000000000000000000000000000000000000", "00", "0xD04116cDd17beBE565EB2422F2497E06cC1C9833", nil},
+		{"example 3", "0x0000000000000000000000000000000000000000", zeroSalt, "deadbeef", "0x70f2b2914A2a4b783FaEFb75f459A580616Fcb5e", nil},
+		{"short salt", "0x0000000000000000000000000000000000000000", "0x00", "00", "", ErrInvalidLength},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			initCode, _ := hex.DecodeString(tt.initCode)
+			initCodeHash := hex.EncodeToString(keccak256(initCode))
+
+			got, err := Create2Address(tt.deployer, tt.salt, initCodeHash)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package address
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/trustwallet/go-primitives/coin"
+)
+
+// Equal reports whether a and b refer to the same account of the coin.
+// Hex addresses are compared case-insensitively, ronin: and 0x prefixes are equivalent,
+// and bech32 accounts are compared by their payload so the HRP and case do not matter.
+// Dual-VM chains also treat the bech32 and 0x forms of an account as equal.
+func Equal(coinID uint, a, b string) bool {
+	if a == b {
+		return true
+	}
+	return EqualityKey(coinID, a) == EqualityKey(coinID, b)
+}
+
+// EqualityKey returns a comparison key for addr such that two addresses of the coin
+// have the same key exactly when Equal reports them as equal. Hex and bech32 accounts
+// are keyed by their 0x-prefixed lower-case payload, so an EVM address without its 0x
+// prefix, which Validate rejects, does not share the key of the prefixed form.
+// Addresses that cannot be parsed are returned unchanged.
+func EqualityKey(coinID uint, addr string) string {
+	_, isBech32 := bech32HRPs[coinID]
+	_, isBridge := evmBridgeHRPs[coinID]
+	if isBech32 || isBridge {
+		if _, data, _, err := Bech32Decode(addr); err == nil {
+			return hexPrefix + hex.EncodeToString(data)
+		}
+	}
+
+	switch coin.Coins[coinID].Blockchain {
+	case coin.BlockchainEthereum, coin.BlockchainTheta, coin.BlockchainVechain, coin.BlockchainGreenfield:
+		if coinID == coin.RONIN && strings.HasPrefix(addr, roninPrefix) {
+			addr = hexPrefix + addr[len(roninPrefix):]
+		}
+		if strings.HasPrefix(addr, hexPrefix) {
+			return strings.ToLower(addr)
+		}
+	}
+	if isBridge && strings.HasPrefix(addr, hexPrefix) {
+		return strings.ToLower(addr)
+	}
+
+	if normalized, err := Normalize(coinID, addr); err == nil {
+		return normalized
+	}
+	return addr
+}
@@ -0,0 +1,53 @@
+package address
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/trustwallet/go-primitives/coin"
+)
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		name   string
+		coinID uint
+		a      string
+		b      string
+		want   bool
+	}{
+		{"identical", coin.SOLANA, "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", true},
+		{"Ethereum case", coin.ETHEREUM, "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", true},
+		{"Ethereum bad checksum still equal", coin.ETHEREUM, "0x5AAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", true},
+		{"Ethereum different", coin.ETHEREUM, "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", "0xea674fdde714fd979de3edf0f56aa9716b898ec8", false},
+		{"Ronin prefix", coin.RONIN, "ronin:ea674fdde714fd979de3edf0f56aa9716b898ec8", "0xEA674fdDe714fd979de3EdF0F56AA9716B898ec8", true},
+		{"Ethereum without 0x prefix", coin.ETHEREUM, "5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", false},
+		{"Ethereum upper case 0X prefix", coin.ETHEREUM, "0X5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED", "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", false},
+		{"Evmos bech32 and unprefixed hex", coin.NATIVEEVMOS, "evmos1t2htvpfl862vnwdqnuekd9p4ulh3h6hd4k0fm4", "5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", false},
+		{"ronin prefix on other chain", coin.ETHEREUM, "ronin:ea674fdde714fd979de3edf0f56aa9716b898ec8", "0xea674fdde714fd979de3edf0f56aa9716b898ec8", false},
+		{"Cosmos case", coin.COSMOS, "COSMOS1HSK6JRYYQJFHP5DHC55TC9JTCKYGX0EPH6DD02", "cosmos1hsk6jryyqjfhp5dhc55tc9jtckygx0eph6dd02", true},
+		{"Osmosis HRP", coin.OSMOSIS, "cosmos1hsk6jryyqjfhp5dhc55tc9jtckygx0eph6dd02", "osmo1hsk6jryyqjfhp5dhc55tc9jtckygx0eplp7aec", true},
+		{"Evmos bech32 and hex", coin.NATIVEEVMOS, "evmos1t2htvpfl862vnwdqnuekd9p4ulh3h6hd4k0fm4", "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", true},
+		{"Harmony bech32 and hex", coin.HARMONY, "one1a50tun737ulcvwy0yvve0pvu5skq0kjargvhwe", "0xed1ebE4Fd1f73f86388F231997859ca42c07DA5d", true},
+		{"Bitcoin segwit case", coin.BITCOIN, "BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4", "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", true},
+		{"Bitcoin legacy case sensitive", coin.BITCOIN, "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa", "1a1zp1ep5qgefi2dmptftl5slmv7divfna", false},
+		{"Bitcoin Cash prefix", coin.BITCOINCASH, "qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a", "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a", true},
+		{"Tron hex and base58", coin.TRON, "41a614f803b6fd780986a42c78ec9c7f77e6ded13c", "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", true},
+		{"TON user-friendly and raw", coin.TON, "EQCD39VS5jcptHL8vMjEXrzGaRcCVYto7HUn4bpAOg8xqB2N", "0:83DFD552E63729B472FCBCC8C45EBCC6691702558B68EC7527E1BA403A0F31A8", true},
+		{"Aptos padding", coin.APTOS, "0x1", "0x0000000000000000000000000000000000000000000000000000000000000001", true},
+		{"unparseable addresses", coin.SOLANA, "sender", "receiver", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Equal(tt.coinID, tt.a, tt.b))
+			assert.Equal(t, tt.want, Equal(tt.coinID, tt.b, tt.a))
+		})
+	}
+}
+
+func TestEqualityKey(t *testing.T) {
+	assert.Equal(t, "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", EqualityKey(coin.ETHEREUM, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"))
+	assert.Equal(t, "5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", EqualityKey(coin.ETHEREUM, "5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"))
+	assert.Equal(t, "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", EqualityKey(coin.NATIVEEVMOS, "evmos1t2htvpfl862vnwdqnuekd9p4ulh3h6hd4k0fm4"))
+}
@@ -0,0 +1,71 @@
+package address
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/trustwallet/go-primitives/coin"
+)
+
+// evmBridgeHRPs holds the bech32 human-readable part of chains whose native accounts
+// share the 20 bytes of their EVM address. Both halves of dual-VM chains are listed.
+// Sei is absent on purpose: its bech32 and EVM addresses are derived from the public key
+// with different hash functions, so one cannot be computed from the other.
+var evmBridgeHRPs = map[uint]string{
+	coin.NATIVEEVMOS:     "evmos",
+	coin.EVMOS:           "evmos",
+	coin.ZETACHAIN:       "zeta",
+	coin.ZETAEVM:         "zeta",
+	coin.NATIVEINJECTIVE: "inj",
+	coin.IOTEX:           "io",
+	coin.IOTEXEVM:        "io",
+	coin.HARMONY:         "one",
+}
+
+// ToEVM converts the bech32 address of a dual-VM chain to its checksummed 0x form.
+// 0x addresses are validated and returned checksummed.
+func ToEVM(coinID uint, addr string) (string, error) {
+	hrp, ok := evmBridgeHRPs[coinID]
+	if !ok {
+		return "", ErrUnsupportedCoin
+	}
+
+	if strings.HasPrefix(addr, hexPrefix) {
+		if err := validateEVMHex(coin.Coins[coinID], addr); err != nil {
+			return "", err
+		}
+		return ChecksumByCoin(coin.Coins[coinID], addr)
+	}
+
+	addrHRP, data, variant, err := Bech32Decode(addr)
+	if err != nil {
+		return "", err
+	}
+	if addrHRP != hrp {
+		return "", ErrInvalidHRP
+	}
+	if variant != Bech32 {
+		return "", ErrInvalidChecksum
+	}
+	if len(data) != 20 {
+		return "", ErrInvalidLength
+	}
+	return ChecksumByCoin(coin.Coins[coinID], hex.EncodeToString(data))
+}
+
+// FromEVM converts a 0x address to the bech32 address of a dual-VM chain.
+func FromEVM(coinID uint, hexAddr string) (string, error) {
+	hrp, ok := evmBridgeHRPs[coinID]
+	if !ok {
+		return "", ErrUnsupportedCoin
+	}
+	if err := validateEVMHex(coin.Coins[coinID], hexAddr); err != nil {
+		return "", err
+	}
+
+	data, err := hex.DecodeString(Remove0x(hexAddr))
+	if err != nil {
+		return "", ErrInvalidCharacter
+	}
+	return Bech32Encode(hrp, data, Bech32)
+}
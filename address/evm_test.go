@@ -0,0 +1,61 @@
+package address
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/trustwallet/go-primitives/coin"
+)
+
+func TestToEVM(t *testing.T) {
+	tests := []struct {
+		name    string
+		coinID  uint
+		addr    string
+		want    string
+		wantErr error
+	}{
+		{"Harmony", coin.HARMONY, "one1a50tun737ulcvwy0yvve0pvu5skq0kjargvhwe", "0xed1ebE4Fd1f73f86388F231997859ca42c07DA5d", nil},
+		{"IoTeX", coin.IOTEX, "io1nyjs526mnqcsx4twa7nptkg08eclsw5c2dywp4", "0x99250a2B5b983103556Eefa615D90f3E71F83a98", nil},
+		{"IoTeX EVM", coin.IOTEXEVM, "io1nyjs526mnqcsx4twa7nptkg08eclsw5c2dywp4", "0x99250a2B5b983103556Eefa615D90f3E71F83a98", nil},
+		{"Evmos", coin.NATIVEEVMOS, "evmos1t2htvpfl862vnwdqnuekd9p4ulh3h6hd4k0fm4", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", nil},
+		{"Zetachain", coin.ZETACHAIN, "zeta1t2htvpfl862vnwdqnuekd9p4ulh3h6hdpnsjmd", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", nil},
+		{"Injective", coin.NATIVEINJECTIVE, "inj1t2htvpfl862vnwdqnuekd9p4ulh3h6hda7frn9", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", nil},
+		{"already hex", coin.ZETAEVM, "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", nil},
+		{"wrong hrp", coin.NATIVEEVMOS, "zeta1t2htvpfl862vnwdqnuekd9p4ulh3h6hdpnsjmd", "", ErrInvalidHRP},
+		{"Sei", coin.SEI, "sei1t2htvpfl862vnwdqnuekd9p4ulh3h6hdzv5uqh", "", ErrUnsupportedCoin},
+		{"Cosmos", coin.COSMOS, "cosmos1hsk6jryyqjfhp5dhc55tc9jtckygx0eph6dd02", "", ErrUnsupportedCoin},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ToEVM(tt.coinID, tt.addr)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFromEVM(t *testing.T) {
+	tests := []struct {
+		name    string
+		coinID  uint
+		hexAddr string
+		want    string
+		wantErr error
+	}{
+		{"Evmos", coin.EVMOS, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", "evmos1t2htvpfl862vnwdqnuekd9p4ulh3h6hd4k0fm4", nil},
+		{"Zetachain", coin.ZETACHAIN, "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", "zeta1t2htvpfl862vnwdqnuekd9p4ulh3h6hdpnsjmd", nil},
+		{"Harmony", coin.HARMONY, "0xed1ebE4Fd1f73f86388F231997859ca42c07DA5d", "one1a50tun737ulcvwy0yvve0pvu5skq0kjargvhwe", nil},
+		{"bad checksum", coin.EVMOS, "0x5AAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", "", ErrInvalidChecksum},
+		{"not EVM", coin.EVMOS, "evmos1t2htvpfl862vnwdqnuekd9p4ulh3h6hd4k0fm4", "", ErrInvalidPrefix},
+		{"unsupported", coin.ETHEREUM, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", "", ErrUnsupportedCoin},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromEVM(tt.coinID, tt.hexAddr)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
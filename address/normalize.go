@@ -0,0 +1,102 @@
+package address
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/trustwallet/go-primitives/coin"
+)
+
+// Normalize validates addr and returns its canonical storage form for the coin:
+//   - EVM chains use the checksummed 0x form, ronin: prefixes are replaced with 0x
+//   - bech32 and CashAddr addresses are lower-cased, CashAddr always carries its prefix
+//   - Tron addresses use the base58check form
+//   - TON addresses use the raw workchain:hex form
+//   - Aptos and Sui addresses are zero-padded to 32 bytes of lower-case hex
+//
+// Addresses of other blockchains are returned unchanged.
+func Normalize(coinID uint, addr string) (string, error) {
+	if err := Validate(coinID, addr); err != nil {
+		return "", err
+	}
+
+	switch c := coin.Coins[coinID]; c.Blockchain {
+	case coin.BlockchainEthereum, coin.BlockchainTheta, coin.BlockchainVechain, coin.BlockchainGreenfield:
+		if coinID == coin.RONIN && strings.HasPrefix(addr, roninPrefix) {
+			addr = hexPrefix + addr[len(roninPrefix):]
+		}
+		return ChecksumByCoin(coin.Coins[coinID], addr)
+	case coin.BlockchainCosmos, coin.BlockchainBinance, coin.BlockchainIoTeX, coin.BlockchainZilliqa,
+		coin.BlockchainHarmony, coin.BlockchainElrond, coin.BlockchainOasis, coin.BlockchainThorchain,
+		coin.BlockchainIcon, coin.BlockchainAion, coin.BlockchainInternetComputer:
+		return strings.ToLower(addr), nil
+	case coin.BlockchainBitcoin, coin.BlockchainGroestlcoin:
+		return normalizeUTXO(coinID, addr), nil
+	case coin.BlockchainCardano:
+		if strings.HasPrefix(strings.ToLower(addr), "addr1") {
+			return strings.ToLower(addr), nil
+		}
+		return addr, nil
+	case coin.BlockchainTron:
+		if isTronHex(addr) {
+			return TronFromHex(addr)
+		}
+		return addr, nil
+	case coin.BlockchainTON:
+		return normalizeTON(addr)
+	case coin.BlockchainAptos, coin.BlockchainSui:
+		return normalizeMoveHex(addr), nil
+	case coin.BlockchainNano:
+		return "nano_" + strings.TrimPrefix(strings.TrimPrefix(addr, "xrb_"), "nano_"), nil
+	case coin.BlockchainNimiq:
+		return normalizeNimiq(addr), nil
+	}
+	return addr, nil
+}
+
+func normalizeUTXO(coinID uint, addr string) string {
+	params := utxoCoins[coinID]
+	lower := strings.ToLower(addr)
+	switch {
+	case params.hrp != "" && strings.HasPrefix(lower, params.hrp+"1"):
+		return lower
+	case params.cashAddrPrefix != "" && strings.HasPrefix(lower, params.cashAddrPrefix+":"):
+		return lower
+	case params.cashAddrPrefix != "" && (lower[0] == 'q' || lower[0] == 'p'):
+		return params.cashAddrPrefix + ":" + lower
+	}
+	return addr
+}
+
+func normalizeMoveHex(addr string) string {
+	body := strings.ToLower(addr[len(hexPrefix):])
+	return hexPrefix + strings.Repeat("0", 64-len(body)) + body
+}
+
+func normalizeNimiq(addr string) string {
+	addr = strings.ToUpper(strings.ReplaceAll(addr, " ", ""))
+	groups := make([]string, 0, len(addr)/4)
+	for i := 0; i < len(addr); i += 4 {
+		groups = append(groups, addr[i:i+4])
+	}
+	return strings.Join(groups, " ")
+}
+
+// normalizeTON converts a valid TON address to the raw workchain:hex form.
+func normalizeTON(addr string) (string, error) {
+	if sep := strings.IndexByte(addr, ':'); sep >= 0 {
+		workchain, err := strconv.ParseInt(addr[:sep], 10, 32)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%d:%s", workchain, strings.ToLower(addr[sep+1:])), nil
+	}
+
+	decoded, err := decodeTONBase64(addr)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d:%s", int8(decoded[1]), hex.EncodeToString(decoded[2:34])), nil
+}
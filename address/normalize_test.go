@@ -0,0 +1,70 @@
+package address
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/trustwallet/go-primitives/coin"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name    string
+		coinID  uint
+		addr    string
+		want    string
+		wantErr error
+	}{
+		{"Ethereum lower case", coin.ETHEREUM, "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", nil},
+		{"Ethereum checksummed", coin.POLYGON, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", nil},
+		{"Ronin prefix", coin.RONIN, "ronin:ea674fdde714fd979de3edf0f56aa9716b898ec8", "0xEA674fdDe714fd979de3EdF0F56AA9716B898ec8", nil},
+		{"Ronin hex", coin.RONIN, "0xea674fdde714fd979de3edf0f56aa9716b898ec8", "0xEA674fdDe714fd979de3EdF0F56AA9716B898ec8", nil},
+		{"Cosmos upper case", coin.COSMOS, "COSMOS1HSK6JRYYQJFHP5DHC55TC9JTCKYGX0EPH6DD02", "cosmos1hsk6jryyqjfhp5dhc55tc9jtckygx0eph6dd02", nil},
+		{"Bitcoin segwit upper case", coin.BITCOIN, "BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4", "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", nil},
+		{"Bitcoin legacy", coin.BITCOIN, "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa", "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa", nil},
+		{"Bitcoin Cash without prefix", coin.BITCOINCASH, "qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a", "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a", nil},
+		{"Tron hex", coin.TRON, "41a614f803b6fd780986a42c78ec9c7f77e6ded13c", "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", nil},
+		{"Tron base58", coin.TRON, "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", nil},
+		{"TON user-friendly", coin.TON, "EQCD39VS5jcptHL8vMjEXrzGaRcCVYto7HUn4bpAOg8xqB2N", "0:83dfd552e63729b472fcbcc8c45ebcc6691702558b68ec7527e1ba403a0f31a8", nil},
+		{"TON raw upper case", coin.TON, "0:83DFD552E63729B472FCBCC8C45EBCC6691702558B68EC7527E1BA403A0F31A8", "0:83dfd552e63729b472fcbcc8c45ebcc6691702558b68ec7527e1ba403a0f31a8", nil},
+		{"Aptos short", coin.APTOS, "0x1", "0x0000000000000000000000000000000000000000000000000000000000000001", nil},
+		{"Sui upper case", coin.SUI, "0xABC", "0x0000000000000000000000000000000000000000000000000000000000000abc", nil},
+		{"Nano legacy prefix", coin.NANO, "xrb_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3", "nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3", nil},
+		{"Nimiq too short", coin.NIMIQ, "nq070000000000000000000000000000000", "", ErrInvalidLength},
+		{"Nimiq grouping", coin.NIMIQ, "nq0700000000000000000000000000000000", "NQ07 0000 0000 0000 0000 0000 0000 0000 0000", nil},
+		{"Solana unchanged", coin.SOLANA, "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", nil},
+		{"Invalid address", coin.ETHEREUM, "0x5AAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", "", ErrInvalidChecksum},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Normalize(tt.coinID, tt.addr)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNormalizeAgreesWithToEIP55ByCoinID(t *testing.T) {
+	tests := []struct {
+		name   string
+		coinID uint
+		addr   string
+	}{
+		{"Ethereum", coin.ETHEREUM, "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"},
+		{"Wanchain", coin.WANCHAIN, "0xae96137e0e05681ed2f5d1af272c3ee512939d0f"},
+		{"Ronin prefix", coin.RONIN, "ronin:ea674fdde714fd979de3edf0f56aa9716b898ec8"},
+		{"Ronin hex", coin.RONIN, "0xea674fdde714fd979de3edf0f56aa9716b898ec8"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			normalized, err := Normalize(tt.coinID, tt.addr)
+			assert.NoError(t, err)
+			eip55, err := ToEIP55ByCoinID(tt.addr, tt.coinID)
+			assert.NoError(t, err)
+			assert.Equal(t, normalized, eip55)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package address
+
+import "github.com/trustwallet/go-primitives/coin"
+
+// utxoParams describes the address formats accepted by a Bitcoin-like coin.
+type utxoParams struct {
+	// versions are the accepted base58check version prefixes
+	versions [][]byte
+	// hrp is the segwit human-readable part, empty if segwit is not supported
+	hrp string
+	// cashAddrPrefix is the CashAddr prefix, empty if CashAddr is not supported
+	cashAddrPrefix string
+	// skipChecksum is set for coins whose base58 checksum is not double SHA-256
+	// (Groestl and BLAKE-256), only the structure of those addresses is verified
+	skipChecksum bool
+}
+
+var utxoCoins = map[uint]utxoParams{
+	coin.BITCOIN:     {versions: [][]byte{{0x00}, {0x05}}, hrp: "bc"},
+	coin.LITECOIN:    {versions: [][]byte{{0x30}, {0x32}, {0x05}}, hrp: "ltc"},
+	coin.DOGE:        {versions: [][]byte{{0x1e}, {0x16}}},
+	coin.DASH:        {versions: [][]byte{{0x4c}, {0x10}}},
+	coin.VIACOIN:     {versions: [][]byte{{0x47}, {0x21}}, hrp: "via"},
+	coin.GROESTLCOIN: {versions: [][]byte{{0x24}, {0x05}}, hrp: "grs", skipChecksum: true},
+	coin.ZCASH:       {versions: [][]byte{{0x1c, 0xb8}, {0x1c, 0xbd}}},
+	coin.FIRO:        {versions: [][]byte{{0x52}, {0x07}}},
+	coin.BITCOINCASH: {versions: [][]byte{{0x00}, {0x05}}, cashAddrPrefix: "bitcoincash"},
+	coin.RAVENCOIN:   {versions: [][]byte{{0x3c}, {0x7a}}},
+	coin.QTUM:        {versions: [][]byte{{0x3a}, {0x32}}, hrp: "qc"},
+	coin.ZELCASH:     {versions: [][]byte{{0x1c, 0xb8}, {0x1c, 0xbd}}},
+	coin.DECRED:      {versions: [][]byte{{0x07, 0x3f}, {0x07, 0x1f}, {0x07, 0x01}, {0x07, 0x1a}}, skipChecksum: true},
+	coin.DIGIBYTE:    {versions: [][]byte{{0x1e}, {0x3f}, {0x05}}, hrp: "dgb"},
+	coin.MONACOIN:    {versions: [][]byte{{0x32}, {0x37}, {0x05}}, hrp: "mona"},
+	coin.BITCOINGOLD: {versions: [][]byte{{0x26}, {0x17}}, hrp: "btg"},
+}
+
+// bech32HRPs holds the human-readable part of every coin using plain bech32 accounts.
+var bech32HRPs = map[uint]string{
+	coin.COSMOS:          "cosmos",
+	coin.KAVA:            "kava",
+	coin.TERRA:           "terra",
+	coin.BAND:            "band",
+	coin.OSMOSIS:         "osmo",
+	coin.NATIVEEVMOS:     "evmos",
+	coin.CRYPTOORG:       "cro",
+	coin.STRIDE:          "stride",
+	coin.NEUTRON:         "neutron",
+	coin.STARGAZE:        "stars",
+	coin.NATIVEINJECTIVE: "inj",
+	coin.AKASH:           "akash",
+	coin.AGORIC:          "agoric",
+	coin.AXELAR:          "axelar",
+	coin.JUNO:            "juno",
+	coin.SEI:             "sei",
+	coin.ZETACHAIN:       "zeta",
+	coin.TIA:             "celestia",
+	coin.DYDX:            "dydx",
+	coin.THORCHAIN:       "thor",
+	coin.BINANCE:         "bnb",
+	coin.IOTEX:           "io",
+	coin.ZILLIQA:         "zil",
+	coin.HARMONY:         "one",
+	coin.ELROND:          "erd",
+	coin.OASIS:           "oasis",
+}
+
+// ss58Prefixes holds the SS58 network prefix of Substrate based coins.
+var ss58Prefixes = map[uint]byte{
+	coin.POLKADOT: 0,
+	coin.KUSAMA:   2,
+	coin.ACALA:    10,
+}
@@ -0,0 +1,163 @@
+package address
+
+import (
+	"crypto/sha256"
+	"errors"
+	"math/big"
+)
+
+const (
+	solanaKeyLength     = 32
+	solanaMaxSeeds      = 16
+	solanaMaxSeedLength = 32
+	solanaPDAMarker     = "ProgramDerivedAddress"
+
+	SolanaTokenProgramID           = "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA"
+	SolanaToken2022ProgramID       = "TokenzQdBNbLqP5VEhdkAS6EPFLC1PHnBqCXEpPxuEb"
+	SolanaAssociatedTokenProgramID = "ATokenGPvbdGVxr1b2hvZbsiqW5xWH25efTNsLJA8knL"
+)
+
+var (
+	ErrInvalidSeeds     = errors.New("invalid program address seeds")
+	ErrOnCurve          = errors.New("program address lies on the ed25519 curve")
+	ErrNoProgramAddress = errors.New("unable to find a viable program address bump")
+)
+
+var (
+	// ed25519P is the field prime 2^255 - 19
+	ed25519P = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(19))
+	// ed25519D is the curve constant -121665/121666
+	ed25519D, _ = new(big.Int).SetString("37095705934669439343138083508754565189542113879843219016388785533085940283555", 10)
+	// ed25519Legendre is (p-1)/2, the exponent of Euler's criterion
+	ed25519Legendre = new(big.Int).Rsh(new(big.Int).Sub(ed25519P, big.NewInt(1)), 1)
+)
+
+// DecodeSolanaKey decodes a base58 Solana public key into its 32 bytes.
+func DecodeSolanaKey(key string) ([]byte, error) {
+	decoded, err := Base58Decode(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(decoded) != solanaKeyLength {
+		return nil, ErrInvalidLength
+	}
+	return decoded, nil
+}
+
+// IsOnCurve reports whether the 32 byte key is the compressed form of an ed25519 point.
+// Program derived addresses are exactly the keys for which it returns false.
+func IsOnCurve(key []byte) bool {
+	if len(key) != solanaKeyLength {
+		return false
+	}
+
+	// the compressed point is the little-endian y coordinate with the sign of x in the top bit
+	be := make([]byte, solanaKeyLength)
+	for i, b := range key {
+		be[solanaKeyLength-1-i] = b
+	}
+	be[0] &= 0x7f
+	y := new(big.Int).SetBytes(be)
+	y.Mod(y, ed25519P)
+
+	// x^2 = (y^2 - 1) / (d*y^2 + 1) must be a square modulo p
+	y2 := new(big.Int).Mul(y, y)
+	u := new(big.Int).Sub(y2, big.NewInt(1))
+	u.Mod(u, ed25519P)
+	v := new(big.Int).Mul(ed25519D, y2)
+	v.Add(v, big.NewInt(1))
+	v.Mod(v, ed25519P)
+
+	x2 := new(big.Int).ModInverse(v, ed25519P)
+	x2.Mul(x2, u)
+	x2.Mod(x2, ed25519P)
+	if x2.Sign() == 0 {
+		return true
+	}
+	return new(big.Int).Exp(x2, ed25519Legendre, ed25519P).Cmp(big.NewInt(1)) == 0
+}
+
+// CreateProgramAddress derives the program address of the seeds, failing with ErrOnCurve
+// when the derived key is a valid ed25519 public key.
+func CreateProgramAddress(seeds [][]byte, programID string) (string, error) {
+	program, err := DecodeSolanaKey(programID)
+	if err != nil {
+		return "", err
+	}
+	key, err := createProgramAddress(seeds, program)
+	if err != nil {
+		return "", err
+	}
+	return Base58Encode(key), nil
+}
+
+// FindProgramAddress searches the bump seeds from 255 downwards and returns the first
+// program address that is off the curve together with its bump.
+func FindProgramAddress(seeds [][]byte, programID string) (string, uint8, error) {
+	program, err := DecodeSolanaKey(programID)
+	if err != nil {
+		return "", 0, err
+	}
+	if len(seeds) >= solanaMaxSeeds {
+		return "", 0, ErrInvalidSeeds
+	}
+
+	bumped := append(append(make([][]byte, 0, len(seeds)+1), seeds...), nil)
+	for bump := 255; bump >= 0; bump-- {
+		bumped[len(seeds)] = []byte{byte(bump)}
+		key, err := createProgramAddress(bumped, program)
+		if errors.Is(err, ErrOnCurve) {
+			continue
+		}
+		if err != nil {
+			return "", 0, err
+		}
+		return Base58Encode(key), uint8(bump), nil
+	}
+	return "", 0, ErrNoProgramAddress
+}
+
+// AssociatedTokenAddress returns the SPL token account holding mint tokens for the owner wallet.
+func AssociatedTokenAddress(owner, mint string) (string, error) {
+	return AssociatedTokenAddressWithProgram(owner, mint, SolanaTokenProgramID)
+}
+
+// AssociatedTokenAddressWithProgram returns the associated token account of the owner
+// for a mint managed by tokenProgramID, e.g. SolanaToken2022ProgramID.
+func AssociatedTokenAddressWithProgram(owner, mint, tokenProgramID string) (string, error) {
+	seeds := make([][]byte, 0, 3)
+	for _, key := range []string{owner, mint, tokenProgramID} {
+		decoded, err := DecodeSolanaKey(key)
+		if err != nil {
+			return "", err
+		}
+		seeds = append(seeds, decoded)
+	}
+	// token seeds are ordered owner, token program, mint
+	seeds[1], seeds[2] = seeds[2], seeds[1]
+
+	ata, _, err := FindProgramAddress(seeds, SolanaAssociatedTokenProgramID)
+	return ata, err
+}
+
+func createProgramAddress(seeds [][]byte, program []byte) ([]byte, error) {
+	if len(seeds) > solanaMaxSeeds {
+		return nil, ErrInvalidSeeds
+	}
+
+	h := sha256.New()
+	for _, seed := range seeds {
+		if len(seed) > solanaMaxSeedLength {
+			return nil, ErrInvalidSeeds
+		}
+		_, _ = h.Write(seed)
+	}
+	_, _ = h.Write(program)
+	_, _ = h.Write([]byte(solanaPDAMarker))
+	key := h.Sum(nil)
+
+	if IsOnCurve(key) {
+		return nil, ErrOnCurve
+	}
+	return key, nil
+}
@@ -0,0 +1,104 @@
+package address
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const bpfLoader = "BPFLoader1111111111111111111111111111111111"
+
+func TestDecodeSolanaKey(t *testing.T) {
+	key, err := DecodeSolanaKey(SolanaAssociatedTokenProgramID)
+	assert.NoError(t, err)
+	assert.Len(t, key, 32)
+
+	_, err = DecodeSolanaKey("1111")
+	assert.Equal(t, ErrInvalidLength, err)
+
+	_, err = DecodeSolanaKey("0OIl")
+	assert.Equal(t, ErrInvalidBase58, err)
+}
+
+func TestIsOnCurve(t *testing.T) {
+	for i := byte(0); i < 16; i++ {
+		pub := ed25519.NewKeyFromSeed(bytes.Repeat([]byte{i}, ed25519.SeedSize)).Public().(ed25519.PublicKey)
+		assert.True(t, IsOnCurve(pub))
+	}
+
+	pda, err := DecodeSolanaKey("DShWnroshVbeUp28oopA3Pu7oFPDBtC1DBmPECXXAQ9n")
+	assert.NoError(t, err)
+	assert.False(t, IsOnCurve(pda))
+	assert.False(t, IsOnCurve([]byte{1, 2, 3}))
+}
+
+func TestCreateProgramAddress(t *testing.T) {
+	seedKey, err := DecodeSolanaKey("SeedPubey1111111111111111111111111111111111")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		seeds   [][]byte
+		want    string
+		wantErr error
+	}{
+		{"empty seed and bump", [][]byte{{}, {1}}, "3gF2KMe9KiC6FNVBmfg9i267aMPvK37FewCip4eGBFcT", nil},
+		{"unicode seed", [][]byte{[]byte("☉")}, "7ytmC1nT1xY4RfxCV2ZgyA7UakC93do5ZdyhdF3EtPj7", nil},
+		{"two seeds", [][]byte{[]byte("Talking"), []byte("Squirrels")}, "HwRVBufQ4haG5XSgpspwKtNd3PC9GM9m1196uJW36vds", nil},
+		{"public key seed", [][]byte{seedKey}, "GUs5qLUfsEHkcMB9T38vjr18ypEhRuNWiePW2LoK4E3K", nil},
+		{"seed too long", [][]byte{make([]byte, 33)}, "", ErrInvalidSeeds},
+		{"too many seeds", make([][]byte, 17), "", ErrInvalidSeeds},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CreateProgramAddress(tt.seeds, bpfLoader)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFindProgramAddress(t *testing.T) {
+	addr, bump, err := FindProgramAddress([][]byte{{}}, bpfLoader)
+	assert.NoError(t, err)
+
+	expected, err := CreateProgramAddress([][]byte{{}, {bump}}, bpfLoader)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, addr)
+
+	_, _, err = FindProgramAddress(make([][]byte, 16), bpfLoader)
+	assert.Equal(t, ErrInvalidSeeds, err)
+
+	_, _, err = FindProgramAddress(nil, "invalid")
+	assert.Error(t, err)
+}
+
+func TestAssociatedTokenAddress(t *testing.T) {
+	tests := []struct {
+		name    string
+		owner   string
+		mint    string
+		want    string
+		wantErr error
+	}{
+		{"token account", "B8UwBUUnKwCyKuGMbFKWaG7exYdDk2ozZrPg72NyVbfj", "7o36UsWR1JQLpZ9PE2gn9L4SQ69CNNiWAXd4Jt7rqz9Z", "DShWnroshVbeUp28oopA3Pu7oFPDBtC1DBmPECXXAQ9n", nil},
+		{"invalid owner", "B8UwBUUnKwCy", "7o36UsWR1JQLpZ9PE2gn9L4SQ69CNNiWAXd4Jt7rqz9Z", "", ErrInvalidLength},
+		{"invalid mint", "B8UwBUUnKwCyKuGMbFKWaG7exYdDk2ozZrPg72NyVbfj", "0x00", "", ErrInvalidBase58},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AssociatedTokenAddress(tt.owner, tt.mint)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	token2022, err := AssociatedTokenAddressWithProgram(
+		"B8UwBUUnKwCyKuGMbFKWaG7exYdDk2ozZrPg72NyVbfj", "7o36UsWR1JQLpZ9PE2gn9L4SQ69CNNiWAXd4Jt7rqz9Z", SolanaToken2022ProgramID)
+	assert.NoError(t, err)
+	assert.NotEqual(t, "DShWnroshVbeUp28oopA3Pu7oFPDBtC1DBmPECXXAQ9n", token2022)
+}
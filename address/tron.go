@@ -0,0 +1,57 @@
+package address
+
+import (
+	"encoding/hex"
+	"strings"
+)
+
+const tronAddressVersion = 0x41
+
+// TronToHex converts a base58check Tron address (T...) to its hex form (41...),
+// verifying the checksum.
+func TronToHex(addr string) (string, error) {
+	payload, err := Base58CheckDecode(addr)
+	if err != nil {
+		return "", err
+	}
+	if err = checkVersionedPayload(payload, [][]byte{{tronAddressVersion}}, 20); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(payload), nil
+}
+
+// TronFromHex converts a hex Tron address (41...) to its base58check form (T...).
+// 20-byte 0x-prefixed addresses, as found in TVM logs, are accepted too.
+func TronFromHex(hexAddr string) (string, error) {
+	payload, err := decodeTronHex(hexAddr)
+	if err != nil {
+		return "", err
+	}
+	return Base58CheckEncode(payload), nil
+}
+
+func decodeTronHex(hexAddr string) ([]byte, error) {
+	if strings.HasPrefix(hexAddr, hexPrefix) {
+		hexAddr = Remove0x(hexAddr)
+		if len(hexAddr) == 40 {
+			hexAddr = "41" + hexAddr
+		}
+	}
+	if len(hexAddr) != 42 {
+		return nil, ErrInvalidLength
+	}
+
+	payload, err := hex.DecodeString(hexAddr)
+	if err != nil {
+		return nil, ErrInvalidCharacter
+	}
+	if payload[0] != tronAddressVersion {
+		return nil, ErrInvalidVersion
+	}
+	return payload, nil
+}
+
+// isTronHex reports whether addr looks like a hex Tron address rather than base58check.
+func isTronHex(addr string) bool {
+	return len(addr) == 42 && isHex(addr) || strings.HasPrefix(addr, hexPrefix)
+}
@@ -0,0 +1,52 @@
+package address
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTronToHex(t *testing.T) {
+	tests := []struct {
+		name    string
+		addr    string
+		want    string
+		wantErr error
+	}{
+		{"USDT contract", "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", "41a614f803b6fd780986a42c78ec9c7f77e6ded13c", nil},
+		{"bad checksum", "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6u", "", ErrInvalidChecksum},
+		{"bitcoin address", "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa", "", ErrInvalidVersion},
+		{"not base58", "0xa614f803b6fd780986a42c78ec9c7f77e6ded13c", "", ErrInvalidBase58},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TronToHex(tt.addr)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTronFromHex(t *testing.T) {
+	tests := []struct {
+		name    string
+		hexAddr string
+		want    string
+		wantErr error
+	}{
+		{"hex", "41a614f803b6fd780986a42c78ec9c7f77e6ded13c", "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", nil},
+		{"upper case hex", "41A614F803B6FD780986A42C78EC9C7F77E6DED13C", "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", nil},
+		{"0x hex", "0x41a614f803b6fd780986a42c78ec9c7f77e6ded13c", "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", nil},
+		{"TVM hex", "0xa614f803b6fd780986a42c78ec9c7f77e6ded13c", "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", nil},
+		{"wrong version", "42a614f803b6fd780986a42c78ec9c7f77e6ded13c", "", ErrInvalidVersion},
+		{"wrong length", "41a614f803b6fd780986a42c78ec9c7f77e6ded1", "", ErrInvalidLength},
+		{"not hex", "41a614f803b6fd780986a42c78ec9c7f77e6ded1zz", "", ErrInvalidCharacter},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TronFromHex(tt.hexAddr)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package address
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Address is a validated address of a coin, kept in the canonical form returned by Normalize.
+// Its text form is the address ID used by subscriptions: "<coin>_<address>".
+type Address struct {
+	coinID uint
+	value  string
+}
+
+// New validates and normalizes addr for the coin.
+func New(coinID uint, addr string) (Address, error) {
+	normalized, err := Normalize(coinID, addr)
+	if err != nil {
+		return Address{}, err
+	}
+	return Address{coinID: coinID, value: normalized}, nil
+}
+
+// MustNew is like New but panics if the address is invalid.
+func MustNew(coinID uint, addr string) Address {
+	a, err := New(coinID, addr)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// Parse parses the "<coin>_<address>" text form.
+func Parse(id string) (Address, error) {
+	sep := strings.IndexByte(id, '_')
+	if sep < 0 {
+		return Address{}, fmt.Errorf("address id %q: %w", id, ErrInvalidInput)
+	}
+	coinID, err := strconv.ParseUint(id[:sep], 10, 32)
+	if err != nil {
+		return Address{}, fmt.Errorf("address id %q: %w", id, ErrInvalidInput)
+	}
+	return New(uint(coinID), id[sep+1:])
+}
+
+func (a Address) CoinID() uint {
+	return a.coinID
+}
+
+// String returns the canonical address without the coin.
+func (a Address) String() string {
+	return a.value
+}
+
+// ID returns the "<coin>_<address>" text form.
+func (a Address) ID() string {
+	if a.IsZero() {
+		return ""
+	}
+	return strconv.FormatUint(uint64(a.coinID), 10) + "_" + a.value
+}
+
+func (a Address) IsZero() bool {
+	return a.value == ""
+}
+
+func (a Address) Equal(other Address) bool {
+	return a.coinID == other.coinID && a.value == other.value
+}
+
+func (a Address) MarshalText() ([]byte, error) {
+	return []byte(a.ID()), nil
+}
+
+func (a *Address) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*a = Address{}
+		return nil
+	}
+	parsed, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*a = parsed
+	return nil
+}
+
+func (a Address) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.ID())
+}
+
+func (a *Address) UnmarshalJSON(data []byte) error {
+	var id string
+	if err := json.Unmarshal(data, &id); err != nil {
+		return err
+	}
+	return a.UnmarshalText([]byte(id))
+}
+
+// Value implements driver.Valuer, the zero Address is stored as NULL.
+func (a Address) Value() (driver.Value, error) {
+	if a.IsZero() {
+		return nil, nil
+	}
+	return a.ID(), nil
+}
+
+// Scan implements sql.Scanner.
+func (a *Address) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*a = Address{}
+		return nil
+	case string:
+		return a.UnmarshalText([]byte(v))
+	case []byte:
+		return a.UnmarshalText(v)
+	default:
+		return fmt.Errorf("cannot scan %T into address.Address", src)
+	}
+}
@@ -0,0 +1,134 @@
+package address
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trustwallet/go-primitives/coin"
+)
+
+var (
+	_ json.Marshaler           = Address{}
+	_ json.Unmarshaler         = &Address{}
+	_ encoding.TextMarshaler   = Address{}
+	_ encoding.TextUnmarshaler = &Address{}
+	_ driver.Valuer            = Address{}
+	_ sql.Scanner              = &Address{}
+)
+
+func TestNew(t *testing.T) {
+	a, err := New(coin.ETHEREUM, "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed")
+	require.NoError(t, err)
+	assert.Equal(t, uint(coin.ETHEREUM), a.CoinID())
+	assert.Equal(t, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", a.String())
+	assert.Equal(t, "60_0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", a.ID())
+	assert.False(t, a.IsZero())
+
+	_, err = New(coin.ETHEREUM, "cosmos1hsk6jryyqjfhp5dhc55tc9jtckygx0eph6dd02")
+	assert.ErrorIs(t, err, ErrInvalidPrefix)
+
+	assert.Panics(t, func() { MustNew(coin.COSMOS, "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed") })
+}
+
+func TestAddressEqual(t *testing.T) {
+	lower := MustNew(coin.ETHEREUM, "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed")
+	checksummed := MustNew(coin.ETHEREUM, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+	otherChain := MustNew(coin.SMARTCHAIN, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+
+	assert.True(t, lower.Equal(checksummed))
+	assert.False(t, lower.Equal(otherChain))
+	assert.True(t, Address{}.Equal(Address{}))
+}
+
+func TestParse(t *testing.T) {
+	a, err := Parse("165_nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3")
+	require.NoError(t, err)
+	assert.Equal(t, uint(coin.NANO), a.CoinID())
+	assert.Equal(t, "nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3", a.String())
+
+	_, err = Parse("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed")
+	assert.ErrorIs(t, err, ErrInvalidInput)
+
+	_, err = Parse("eth_0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed")
+	assert.ErrorIs(t, err, ErrInvalidInput)
+
+	_, err = Parse("118_0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed")
+	assert.ErrorIs(t, err, ErrInvalidBech32)
+}
+
+func TestAddressJSON(t *testing.T) {
+	type subscription struct {
+		Address Address `json:"address"`
+	}
+
+	in := subscription{Address: MustNew(coin.COSMOS, "COSMOS1HSK6JRYYQJFHP5DHC55TC9JTCKYGX0EPH6DD02")}
+	data, err := json.Marshal(in)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"address":"118_cosmos1hsk6jryyqjfhp5dhc55tc9jtckygx0eph6dd02"}`, string(data))
+
+	var out subscription
+	require.NoError(t, json.Unmarshal(data, &out))
+	assert.True(t, in.Address.Equal(out.Address))
+
+	require.NoError(t, json.Unmarshal([]byte(`{"address":null}`), &out))
+	assert.True(t, out.Address.IsZero())
+
+	assert.Error(t, json.Unmarshal([]byte(`{"address":"118_cosmos1hsk6jryyqjfhp5dhc55tc9jtckygx0eph6dd03"}`), &out))
+	assert.Error(t, json.Unmarshal([]byte(`{"address":118}`), &out))
+}
+
+func TestAddressText(t *testing.T) {
+	a := MustNew(coin.TRON, "41a614f803b6fd780986a42c78ec9c7f77e6ded13c")
+	text, err := a.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "195_TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", string(text))
+
+	var parsed Address
+	require.NoError(t, parsed.UnmarshalText(text))
+	assert.True(t, a.Equal(parsed))
+
+	require.NoError(t, parsed.UnmarshalText(nil))
+	assert.True(t, parsed.IsZero())
+}
+
+func TestAddressSQL(t *testing.T) {
+	a := MustNew(coin.SOLANA, "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v")
+	value, err := a.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "501_EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", value)
+
+	value, err = Address{}.Value()
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	tests := []struct {
+		name    string
+		src     interface{}
+		want    Address
+		wantErr bool
+	}{
+		{"string", "501_EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", a, false},
+		{"bytes", []byte("501_EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v"), a, false},
+		{"null", nil, Address{}, false},
+		{"invalid", "501_0x", Address{}, true},
+		{"unsupported type", 501, Address{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Address
+			err := got.Scan(tt.src)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, tt.want.Equal(got))
+		})
+	}
+}
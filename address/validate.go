@@ -0,0 +1,873 @@
+package address
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+
+	"github.com/trustwallet/go-primitives/coin"
+)
+
+var (
+	ErrUnsupportedCoin  = errors.New("unsupported coin")
+	ErrEmptyAddress     = errors.New("empty address")
+	ErrInvalidLength    = errors.New("invalid length")
+	ErrInvalidPrefix    = errors.New("invalid prefix")
+	ErrInvalidHRP       = errors.New("invalid human-readable part")
+	ErrInvalidVersion   = errors.New("invalid version")
+	ErrInvalidCharacter = errors.New("invalid character")
+)
+
+const (
+	hexPrefix   = "0x"
+	roninPrefix = "ronin:"
+)
+
+// ValidationError is returned by Validate, Err is one of the Err* values
+// describing which check failed.
+type ValidationError struct {
+	CoinID  uint
+	Address string
+	Err     error
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid address %q for coin %d: %v", e.Address, e.CoinID, e.Err)
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+var validators = map[string]func(coinID uint, addr string) error{
+	coin.BlockchainAeternity:        validateAeternity,
+	coin.BlockchainAion:             validateAion,
+	coin.BlockchainAlgorand:         validateAlgorand,
+	coin.BlockchainAptos:            validateMoveHex,
+	coin.BlockchainBinance:          validateBech32Account,
+	coin.BlockchainBitcoin:          validateUTXO,
+	coin.BlockchainCardano:          validateCardano,
+	coin.BlockchainCosmos:           validateBech32Account,
+	coin.BlockchainDecred:           validateUTXO,
+	coin.BlockchainElrond:           validateBech32Account,
+	coin.BlockchainEOS:              validateEOS,
+	coin.BlockchainEthereum:         validateEthereum,
+	coin.BlockchainFilecoin:         validateFilecoin,
+	coin.BlockchainFIO:              validateFIO,
+	coin.BlockchainGreenfield:       validateEthereum,
+	coin.BlockchainGroestlcoin:      validateUTXO,
+	coin.BlockchainHarmony:          validateBech32Account,
+	coin.BlockchainIcon:             validateIcon,
+	coin.BlockchainInternetComputer: validateInternetComputer,
+	coin.BlockchainIoTeX:            validateBech32Account,
+	coin.BlockchainKusama:           validateSS58,
+	coin.BlockchainNano:             validateNano,
+	coin.BlockchainNEAR:             validateNEAR,
+	coin.BlockchainNebulas:          validateNebulas,
+	coin.BlockchainNEO:              validateNEO,
+	coin.BlockchainNimiq:            validateNimiq,
+	coin.BlockchainNULS:             validateNULS,
+	coin.BlockchainOasis:            validateBech32Account,
+	coin.BlockchainOntology:         validateOntology,
+	coin.BlockchainPolkadot:         validateSS58,
+	coin.BlockchainRipple:           validateRipple,
+	coin.BlockchainSolana:           validateSolana,
+	coin.BlockchainStellar:          validateStellar,
+	coin.BlockchainSui:              validateMoveHex,
+	coin.BlockchainTezos:            validateTezos,
+	coin.BlockchainTheta:            validateEthereum,
+	coin.BlockchainThorchain:        validateBech32Account,
+	coin.BlockchainTON:              validateTON,
+	coin.BlockchainTron:             validateTron,
+	coin.BlockchainVechain:          validateEthereum,
+	coin.BlockchainWaves:            validateWaves,
+	coin.BlockchainZcash:            validateUTXO,
+	coin.BlockchainZilliqa:          validateBech32Account,
+}
+
+// Validate checks that addr is a well-formed address of the given coin.
+// A non-nil error is always a *ValidationError.
+func Validate(coinID uint, addr string) error {
+	err := validate(coinID, addr)
+	if err != nil {
+		return &ValidationError{CoinID: coinID, Address: addr, Err: err}
+	}
+	return nil
+}
+
+func validate(coinID uint, addr string) error {
+	c, ok := coin.Coins[coinID]
+	if !ok {
+		return ErrUnsupportedCoin
+	}
+	validateFn, ok := validators[c.Blockchain]
+	if !ok {
+		return ErrUnsupportedCoin
+	}
+	if addr == "" {
+		return ErrEmptyAddress
+	}
+	return validateFn(coinID, addr)
+}
+
+func isHex(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F') {
+			return false
+		}
+	}
+	return true
+}
+
+func validateEthereum(coinID uint, addr string) error {
+	if coinID == coin.RONIN && strings.HasPrefix(addr, roninPrefix) {
+		addr = hexPrefix + addr[len(roninPrefix):]
+	}
+	return validateEVMHex(coin.Coins[coinID], addr)
+}
+
+// validateEVMHex accepts all lower-case, all upper-case or checksummed addresses,
+// the checksum scheme depends on the coin.
+func validateEVMHex(c coin.Coin, addr string) error {
+	if !strings.HasPrefix(addr, hexPrefix) {
+		return ErrInvalidPrefix
+	}
+	body := addr[len(hexPrefix):]
+	if len(body) != 40 {
+		return ErrInvalidLength
+	}
+	if !isHex(body) {
+		return ErrInvalidCharacter
+	}
+	if body == strings.ToLower(body) || body == strings.ToUpper(body) {
+		return nil
+	}
+
+	checksummed, err := ChecksumByCoin(c, addr)
+	if err != nil || checksummed != addr {
+		return ErrInvalidChecksum
+	}
+	return nil
+}
+
+// validateMoveHex accepts Aptos and Sui addresses, leading zeros may be omitted.
+func validateMoveHex(_ uint, addr string) error {
+	if !strings.HasPrefix(addr, hexPrefix) {
+		return ErrInvalidPrefix
+	}
+	body := addr[len(hexPrefix):]
+	if len(body) == 0 || len(body) > 64 {
+		return ErrInvalidLength
+	}
+	if !isHex(body) {
+		return ErrInvalidCharacter
+	}
+	return nil
+}
+
+func validateIcon(_ uint, addr string) error {
+	if !strings.HasPrefix(addr, "hx") && !strings.HasPrefix(addr, "cx") {
+		return ErrInvalidPrefix
+	}
+	if len(addr) != 42 {
+		return ErrInvalidLength
+	}
+	if body := addr[2:]; !isHex(body) || strings.ToLower(body) != body {
+		return ErrInvalidCharacter
+	}
+	return nil
+}
+
+func validateAion(_ uint, addr string) error {
+	if !strings.HasPrefix(addr, hexPrefix+"a0") {
+		return ErrInvalidPrefix
+	}
+	body := addr[len(hexPrefix):]
+	if len(body) != 64 {
+		return ErrInvalidLength
+	}
+	if !isHex(body) {
+		return ErrInvalidCharacter
+	}
+	return nil
+}
+
+func validateUTXO(coinID uint, addr string) error {
+	params, ok := utxoCoins[coinID]
+	if !ok {
+		return ErrUnsupportedCoin
+	}
+
+	lower := strings.ToLower(addr)
+	if params.hrp != "" && strings.HasPrefix(lower, params.hrp+"1") {
+		return validateSegwit(addr, params.hrp)
+	}
+	if params.cashAddrPrefix != "" &&
+		(strings.HasPrefix(lower, params.cashAddrPrefix+":") || lower[0] == 'q' || lower[0] == 'p') {
+		return validateCashAddr(addr, params.cashAddrPrefix)
+	}
+
+	var (
+		payload []byte
+		err     error
+	)
+	if params.skipChecksum {
+		payload, err = bitcoinBase58.decodeString(addr)
+		if err == nil {
+			if len(payload) < 4 {
+				return ErrInvalidLength
+			}
+			payload = payload[:len(payload)-4]
+		}
+	} else {
+		payload, err = bitcoinBase58.decodeCheck(addr)
+	}
+	if err != nil {
+		return err
+	}
+
+	return checkVersionedPayload(payload, params.versions, 20)
+}
+
+// checkVersionedPayload verifies that payload is one of versions followed by size bytes.
+func checkVersionedPayload(payload []byte, versions [][]byte, size int) error {
+	for _, v := range versions {
+		if bytes.HasPrefix(payload, v) {
+			if len(payload) != len(v)+size {
+				return ErrInvalidLength
+			}
+			return nil
+		}
+	}
+	return ErrInvalidVersion
+}
+
+func validateSegwit(addr, expectedHRP string) error {
+	hrp, data, variant, err := decodeBech32(addr, bech32MaxLength)
+	if err != nil {
+		return err
+	}
+	if hrp != expectedHRP {
+		return ErrInvalidHRP
+	}
+	if len(data) < 1 {
+		return ErrInvalidLength
+	}
+
+	version := data[0]
+	if version > 16 {
+		return ErrInvalidVersion
+	}
+	program, err := convertBits(data[1:], 5, 8, false)
+	if err != nil {
+		return err
+	}
+	if len(program) < 2 || len(program) > 40 || version == 0 && len(program) != 20 && len(program) != 32 {
+		return ErrInvalidLength
+	}
+	if version == 0 && variant != Bech32 || version != 0 && variant != Bech32m {
+		return ErrInvalidChecksum
+	}
+	return nil
+}
+
+func validateCashAddr(addr, prefix string) error {
+	payload, err := decodeCashAddr(addr, prefix)
+	if err != nil {
+		return err
+	}
+	if len(payload) != 21 {
+		return ErrInvalidLength
+	}
+	// only the 160-bit P2PKH (0) and P2SH (8) types are in use
+	if payload[0] != 0 && payload[0] != 8 {
+		return ErrInvalidVersion
+	}
+	return nil
+}
+
+func validateBech32Account(coinID uint, addr string) error {
+	expectedHRP, ok := bech32HRPs[coinID]
+	if !ok {
+		return ErrUnsupportedCoin
+	}
+
+	hrp, data, variant, err := decodeBech32(addr, bech32MaxLength)
+	if err != nil {
+		return err
+	}
+	if hrp != expectedHRP {
+		return ErrInvalidHRP
+	}
+	if variant != Bech32 {
+		return ErrInvalidChecksum
+	}
+	payload, err := convertBits(data, 5, 8, false)
+	if err != nil {
+		return err
+	}
+
+	switch coin.Coins[coinID].Blockchain {
+	case coin.BlockchainCosmos:
+		// 32 bytes are used by module and interchain accounts as well as CosmWasm contracts
+		if len(payload) != 20 && len(payload) != 32 {
+			return ErrInvalidLength
+		}
+	case coin.BlockchainElrond:
+		if len(payload) != 32 {
+			return ErrInvalidLength
+		}
+	case coin.BlockchainOasis:
+		if len(payload) != 21 {
+			return ErrInvalidLength
+		}
+		if payload[0] != 0 {
+			return ErrInvalidVersion
+		}
+	default:
+		if len(payload) != 20 {
+			return ErrInvalidLength
+		}
+	}
+	return nil
+}
+
+// validateTron accepts base58check and hex addresses.
+func validateTron(_ uint, addr string) error {
+	if isTronHex(addr) {
+		_, err := decodeTronHex(addr)
+		return err
+	}
+	_, err := TronToHex(addr)
+	return err
+}
+
+func validateOntology(_ uint, addr string) error {
+	payload, err := Base58CheckDecode(addr)
+	if err != nil {
+		return err
+	}
+	return checkVersionedPayload(payload, [][]byte{{0x17}}, 20)
+}
+
+// validateNEO accepts both legacy and N3 addresses.
+func validateNEO(_ uint, addr string) error {
+	payload, err := Base58CheckDecode(addr)
+	if err != nil {
+		return err
+	}
+	return checkVersionedPayload(payload, [][]byte{{0x17}, {0x35}}, 20)
+}
+
+func validateTezos(_ uint, addr string) error {
+	payload, err := Base58CheckDecode(addr)
+	if err != nil {
+		return err
+	}
+	versions := [][]byte{
+		{0x06, 0xa1, 0x9f}, // tz1
+		{0x06, 0xa1, 0xa1}, // tz2
+		{0x06, 0xa1, 0xa4}, // tz3
+		{0x06, 0xa1, 0xa6}, // tz4
+		{0x02, 0x5a, 0x79}, // KT1
+	}
+	return checkVersionedPayload(payload, versions, 20)
+}
+
+func validateAeternity(_ uint, addr string) error {
+	const prefix = "ak_"
+	if !strings.HasPrefix(addr, prefix) {
+		return ErrInvalidPrefix
+	}
+	payload, err := Base58CheckDecode(addr[len(prefix):])
+	if err != nil {
+		return err
+	}
+	if len(payload) != 32 {
+		return ErrInvalidLength
+	}
+	return nil
+}
+
+// validateRipple accepts classic and X-addresses.
+func validateRipple(_ uint, addr string) error {
+	payload, err := rippleBase58.decodeCheck(addr)
+	if err != nil {
+		return err
+	}
+	if addr[0] == 'X' {
+		return checkVersionedPayload(payload, [][]byte{{0x05, 0x44}}, 29)
+	}
+	return checkVersionedPayload(payload, [][]byte{{0x00}}, 20)
+}
+
+func validateSolana(_ uint, addr string) error {
+	decoded, err := Base58Decode(addr)
+	if err != nil {
+		return err
+	}
+	if len(decoded) != 32 {
+		return ErrInvalidLength
+	}
+	return nil
+}
+
+// stdBase32Encoding is the unpadded RFC 4648 alphabet used by Stellar and Algorand.
+var stdBase32Encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// validateStellar accepts account (G...) and muxed account (M...) strkeys.
+func validateStellar(_ uint, addr string) error {
+	const (
+		accountVersion = 6 << 3
+		muxedVersion   = 12 << 3
+	)
+	decoded, err := stdBase32Encoding.DecodeString(addr)
+	if err != nil {
+		return ErrInvalidCharacter
+	}
+
+	switch {
+	case len(decoded) > 0 && decoded[0] == accountVersion:
+		if len(decoded) != 35 {
+			return ErrInvalidLength
+		}
+	case len(decoded) > 0 && decoded[0] == muxedVersion:
+		if len(decoded) != 43 {
+			return ErrInvalidLength
+		}
+	default:
+		return ErrInvalidVersion
+	}
+
+	body := decoded[:len(decoded)-2]
+	if binary.LittleEndian.Uint16(decoded[len(decoded)-2:]) != crc16XModem(body) {
+		return ErrInvalidChecksum
+	}
+	return nil
+}
+
+func validateFIO(_ uint, addr string) error {
+	const prefix = "FIO"
+	if !strings.HasPrefix(addr, prefix) {
+		return ErrInvalidPrefix
+	}
+	decoded, err := Base58Decode(addr[len(prefix):])
+	if err != nil {
+		return err
+	}
+	if len(decoded) != 37 {
+		return ErrInvalidLength
+	}
+	if !bytes.Equal(ripemd160Sum(decoded[:33])[:4], decoded[33:]) {
+		return ErrInvalidChecksum
+	}
+	return nil
+}
+
+const nimiqAlphabet = "0123456789ABCDEFGHJKLMNPQRSTUVXY"
+
+func validateNimiq(_ uint, addr string) error {
+	addr = strings.ToUpper(strings.ReplaceAll(addr, " ", ""))
+	if !strings.HasPrefix(addr, "NQ") {
+		return ErrInvalidPrefix
+	}
+	if len(addr) != 36 {
+		return ErrInvalidLength
+	}
+	for i := 4; i < len(addr); i++ {
+		if strings.IndexByte(nimiqAlphabet, addr[i]) < 0 {
+			return ErrInvalidCharacter
+		}
+	}
+	if !isDigits(addr[2:4]) {
+		return ErrInvalidCharacter
+	}
+
+	// IBAN check digits
+	var numeric strings.Builder
+	for _, c := range addr[4:] + addr[:4] {
+		if c >= 'A' && c <= 'Z' {
+			numeric.WriteString(strconv.Itoa(int(c-'A') + 10))
+		} else {
+			numeric.WriteRune(c)
+		}
+	}
+	n, _ := new(big.Int).SetString(numeric.String(), 10)
+	if n.Mod(n, big.NewInt(97)).Int64() != 1 {
+		return ErrInvalidChecksum
+	}
+	return nil
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return len(s) > 0
+}
+
+func validateNebulas(_ uint, addr string) error {
+	decoded, err := Base58Decode(addr)
+	if err != nil {
+		return err
+	}
+	if len(decoded) != 26 {
+		return ErrInvalidLength
+	}
+	// 0x57 is a regular account, 0x58 a contract
+	if decoded[0] != 0x19 || decoded[1] != 0x57 && decoded[1] != 0x58 {
+		return ErrInvalidVersion
+	}
+	sum := sha3.Sum256(decoded[:22])
+	if !bytes.Equal(sum[:4], decoded[22:]) {
+		return ErrInvalidChecksum
+	}
+	return nil
+}
+
+func validateWaves(_ uint, addr string) error {
+	decoded, err := Base58Decode(addr)
+	if err != nil {
+		return err
+	}
+	if len(decoded) != 26 {
+		return ErrInvalidLength
+	}
+	if decoded[0] != 0x01 || decoded[1] != 'W' {
+		return ErrInvalidVersion
+	}
+	if !bytes.Equal(keccak256(blake2bSum(32, decoded[:22]))[:4], decoded[22:]) {
+		return ErrInvalidChecksum
+	}
+	return nil
+}
+
+func validateAlgorand(_ uint, addr string) error {
+	if len(addr) != 58 {
+		return ErrInvalidLength
+	}
+	decoded, err := stdBase32Encoding.DecodeString(addr)
+	if err != nil {
+		return ErrInvalidCharacter
+	}
+	sum := sha512.Sum512_256(decoded[:32])
+	if !bytes.Equal(sum[28:], decoded[32:]) {
+		return ErrInvalidChecksum
+	}
+	return nil
+}
+
+const nanoAlphabet = "13456789abcdefghijkmnopqrstuwxyz"
+
+func validateNano(_ uint, addr string) error {
+	var body string
+	switch {
+	case strings.HasPrefix(addr, "nano_"):
+		body = addr[len("nano_"):]
+	case strings.HasPrefix(addr, "xrb_"):
+		body = addr[len("xrb_"):]
+	default:
+		return ErrInvalidPrefix
+	}
+	if len(body) != 60 {
+		return ErrInvalidLength
+	}
+
+	// 4 padding bits, 256 bits of public key and 40 bits of checksum
+	n := new(big.Int)
+	for i := 0; i < len(body); i++ {
+		d := strings.IndexByte(nanoAlphabet, body[i])
+		if d < 0 {
+			return ErrInvalidCharacter
+		}
+		n.Lsh(n, 5)
+		n.Or(n, big.NewInt(int64(d)))
+	}
+	if n.BitLen() > 296 {
+		return ErrInvalidCharacter
+	}
+	raw := n.FillBytes(make([]byte, 37))
+	key, checksum := raw[:32], raw[32:]
+
+	sum := blake2bSum(5, key)
+	for i := range sum {
+		if sum[i] != checksum[len(checksum)-1-i] {
+			return ErrInvalidChecksum
+		}
+	}
+	return nil
+}
+
+func validateSS58(coinID uint, addr string) error {
+	prefix, ok := ss58Prefixes[coinID]
+	if !ok {
+		return ErrUnsupportedCoin
+	}
+	decoded, err := Base58Decode(addr)
+	if err != nil {
+		return err
+	}
+	if len(decoded) != 35 {
+		return ErrInvalidLength
+	}
+	if decoded[0] != prefix {
+		return ErrInvalidVersion
+	}
+	if !bytes.Equal(blake2bSum(64, []byte("SS58PRE"), decoded[:33])[:2], decoded[33:]) {
+		return ErrInvalidChecksum
+	}
+	return nil
+}
+
+var nearAccountRegexp = regexp.MustCompile(`^(([a-z\d]+[\-_])*[a-z\d]+\.)*([a-z\d]+[\-_])*[a-z\d]+$`)
+
+// validateNEAR accepts implicit (hex encoded public key) and named accounts.
+func validateNEAR(_ uint, addr string) error {
+	if len(addr) == 64 && isHex(addr) && strings.ToLower(addr) == addr {
+		return nil
+	}
+	if len(addr) < 2 || len(addr) > 64 {
+		return ErrInvalidLength
+	}
+	if !nearAccountRegexp.MatchString(addr) {
+		return ErrInvalidCharacter
+	}
+	return nil
+}
+
+var eosAccountRegexp = regexp.MustCompile(`^[a-z1-5.]{0,11}[a-z1-5]$`)
+
+func validateEOS(_ uint, addr string) error {
+	if len(addr) > 12 {
+		return ErrInvalidLength
+	}
+	if !eosAccountRegexp.MatchString(addr) {
+		return ErrInvalidCharacter
+	}
+	return nil
+}
+
+// lowerBase32Encoding is the unpadded lower-case RFC 4648 alphabet used by Filecoin and Internet Computer.
+var lowerBase32Encoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+func validateFilecoin(_ uint, addr string) error {
+	if len(addr) < 3 || addr[0] != 'f' {
+		return ErrInvalidPrefix
+	}
+
+	protocol := addr[1]
+	body := addr[2:]
+	header := []byte{protocol - '0'}
+	var payloadSize int
+	switch protocol {
+	case '0':
+		if _, err := strconv.ParseUint(body, 10, 64); err != nil {
+			return ErrInvalidCharacter
+		}
+		return nil
+	case '1', '2':
+		payloadSize = 20
+	case '3':
+		payloadSize = 48
+	case '4':
+		sep := strings.IndexByte(body, 'f')
+		if sep < 1 {
+			return ErrInvalidPrefix
+		}
+		namespace, err := strconv.ParseUint(body[:sep], 10, 64)
+		if err != nil {
+			return ErrInvalidCharacter
+		}
+		header = binary.AppendUvarint(header, namespace)
+		body = body[sep+1:]
+	default:
+		return ErrInvalidVersion
+	}
+
+	decoded, err := lowerBase32Encoding.DecodeString(body)
+	if err != nil {
+		return ErrInvalidCharacter
+	}
+	if len(decoded) <= 4 || payloadSize > 0 && len(decoded) != payloadSize+4 {
+		return ErrInvalidLength
+	}
+	payload, checksum := decoded[:len(decoded)-4], decoded[len(decoded)-4:]
+	if !bytes.Equal(blake2bSum(4, header, payload), checksum) {
+		return ErrInvalidChecksum
+	}
+	return nil
+}
+
+func validateNULS(_ uint, addr string) error {
+	const prefix = "NULSd"
+	if !strings.HasPrefix(addr, prefix) {
+		return ErrInvalidPrefix
+	}
+	decoded, err := Base58Decode(addr[len(prefix):])
+	if err != nil {
+		return err
+	}
+	if len(decoded) != 24 {
+		return ErrInvalidLength
+	}
+	if binary.LittleEndian.Uint16(decoded) != 1 {
+		return ErrInvalidVersion
+	}
+	var xor byte
+	for _, b := range decoded[:23] {
+		xor ^= b
+	}
+	if xor != decoded[23] {
+		return ErrInvalidChecksum
+	}
+	return nil
+}
+
+// validateCardano accepts Shelley bech32 and Byron base58 addresses.
+func validateCardano(_ uint, addr string) error {
+	if strings.HasPrefix(addr, "addr1") {
+		hrp, data, _, err := decodeBech32(addr, bech32LongMaxLength)
+		if err != nil {
+			return err
+		}
+		if hrp != "addr" {
+			return ErrInvalidHRP
+		}
+		payload, err := convertBits(data, 5, 8, false)
+		if err != nil {
+			return err
+		}
+		// header byte followed by at least a 28-byte payment credential
+		if len(payload) < 29 {
+			return ErrInvalidLength
+		}
+		return nil
+	}
+
+	decoded, err := Base58Decode(addr)
+	if err != nil {
+		return err
+	}
+	return validateByron(decoded)
+}
+
+// validateByron checks the CBOR envelope [tag24(bytes), crc32] of a Byron address.
+func validateByron(decoded []byte) error {
+	if len(decoded) < 4 || decoded[0] != 0x82 || decoded[1] != 0xd8 || decoded[2] != 0x18 {
+		return ErrInvalidVersion
+	}
+	rest := decoded[3:]
+
+	payloadLen, rest, ok := readCBORHead(rest, 0x40)
+	if !ok || uint64(len(rest)) < payloadLen {
+		return ErrInvalidLength
+	}
+	payload, rest := rest[:payloadLen], rest[payloadLen:]
+
+	crc, rest, ok := readCBORHead(rest, 0x00)
+	if !ok || len(rest) != 0 {
+		return ErrInvalidLength
+	}
+	if uint64(crc32.ChecksumIEEE(payload)) != crc {
+		return ErrInvalidChecksum
+	}
+	return nil
+}
+
+// readCBORHead reads the argument of a CBOR item of the given major type.
+func readCBORHead(data []byte, majorType byte) (uint64, []byte, bool) {
+	if len(data) == 0 || data[0]&0xe0 != majorType {
+		return 0, nil, false
+	}
+	info := data[0] & 0x1f
+	data = data[1:]
+	if info < 24 {
+		return uint64(info), data, true
+	}
+
+	size := 1 << (info - 24)
+	if info > 27 || len(data) < size {
+		return 0, nil, false
+	}
+	var value uint64
+	for _, b := range data[:size] {
+		value = value<<8 | uint64(b)
+	}
+	return value, data[size:], true
+}
+
+// validateTON accepts raw (workchain:hex) and user-friendly base64 addresses.
+func validateTON(_ uint, addr string) error {
+	if sep := strings.IndexByte(addr, ':'); sep >= 0 {
+		if _, err := strconv.ParseInt(addr[:sep], 10, 32); err != nil {
+			return ErrInvalidVersion
+		}
+		body := addr[sep+1:]
+		if len(body) != 64 {
+			return ErrInvalidLength
+		}
+		if !isHex(body) {
+			return ErrInvalidCharacter
+		}
+		return nil
+	}
+
+	if len(addr) != 48 {
+		return ErrInvalidLength
+	}
+	decoded, err := decodeTONBase64(addr)
+	if err != nil {
+		return ErrInvalidCharacter
+	}
+	// bounceable, non-bounceable, with optional test-only flag
+	if flag := decoded[0] &^ 0x80; flag != 0x11 && flag != 0x51 {
+		return ErrInvalidVersion
+	}
+	if binary.BigEndian.Uint16(decoded[34:]) != crc16XModem(decoded[:34]) {
+		return ErrInvalidChecksum
+	}
+	return nil
+}
+
+func decodeTONBase64(addr string) ([]byte, error) {
+	if strings.ContainsAny(addr, "-_") {
+		return base64.RawURLEncoding.DecodeString(addr)
+	}
+	return base64.RawStdEncoding.DecodeString(addr)
+}
+
+// validateInternetComputer accepts hex account identifiers and textual principals.
+func validateInternetComputer(_ uint, addr string) error {
+	var decoded []byte
+	if len(addr) == 64 && isHex(addr) {
+		decoded, _ = hex.DecodeString(addr)
+	} else {
+		var err error
+		decoded, err = lowerBase32Encoding.DecodeString(strings.ReplaceAll(addr, "-", ""))
+		if err != nil {
+			return ErrInvalidCharacter
+		}
+		if len(decoded) < 4 || len(decoded) > 33 {
+			return ErrInvalidLength
+		}
+	}
+	if binary.BigEndian.Uint32(decoded) != crc32.ChecksumIEEE(decoded[4:]) {
+		return ErrInvalidChecksum
+	}
+	return nil
+}
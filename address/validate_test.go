@@ -0,0 +1,131 @@
+package address
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trustwallet/go-primitives/coin"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		coinID  uint
+		addr    string
+		wantErr error
+	}{
+		{"Ethereum checksummed", coin.ETHEREUM, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", nil},
+		{"Ethereum lower case", coin.SMARTCHAIN, "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", nil},
+		{"Ethereum bad checksum", coin.ETHEREUM, "0x5AAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", ErrInvalidChecksum},
+		{"Ethereum no prefix", coin.ETHEREUM, "5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", ErrInvalidPrefix},
+		{"Ethereum short", coin.ETHEREUM, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeA", ErrInvalidLength},
+		{"Ethereum bad character", coin.ETHEREUM, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeg", ErrInvalidCharacter},
+		{"Ronin", coin.RONIN, "ronin:ea674fdde714fd979de3edf0f56aa9716b898ec8", nil},
+		{"Ronin prefix on other chain", coin.ETHEREUM, "ronin:ea674fdde714fd979de3edf0f56aa9716b898ec8", ErrInvalidPrefix},
+		{"Theta", coin.THETA, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", nil},
+		{"Greenfield", coin.GBNB, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", nil},
+		{"Bitcoin P2PKH", coin.BITCOIN, "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa", nil},
+		{"Bitcoin P2SH", coin.BITCOIN, "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy", nil},
+		{"Bitcoin bad checksum", coin.BITCOIN, "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNb", ErrInvalidChecksum},
+		{"Bitcoin bad base58", coin.BITCOIN, "1A1zP1eP5QGefi2DMPTfTL5SLmv7Div0Na", ErrInvalidBase58},
+		{"Bitcoin address on Doge", coin.DOGE, "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa", ErrInvalidVersion},
+		{"Bitcoin segwit v0", coin.BITCOIN, "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", nil},
+		{"Bitcoin taproot", coin.BITCOIN, "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0", nil},
+		{"Bitcoin segwit v0 with bech32m", coin.BITCOIN, "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kemeawh", ErrInvalidChecksum},
+		{"Bitcoin segwit on Litecoin", coin.LITECOIN, "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", ErrInvalidBase58},
+		{"Doge", coin.DOGE, "DH5yaieqoZN36fDVciNyRueRGvGLR3mr7L", nil},
+		{"Bitcoin Cash", coin.BITCOINCASH, "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a", nil},
+		{"Bitcoin Cash without prefix", coin.BITCOINCASH, "qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a", nil},
+		{"Bitcoin Cash legacy", coin.BITCOINCASH, "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa", nil},
+		{"Bitcoin Cash bad checksum", coin.BITCOINCASH, "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6c", ErrInvalidChecksum},
+		{"Zcash", coin.ZCASH, "t1Hsc1LR8yKnbbe3twRp88p6vFfC5t7DLbs", nil},
+		{"Tron", coin.TRON, "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", nil},
+		{"Tron hex", coin.TRON, "41a614f803b6fd780986a42c78ec9c7f77e6ded13c", nil},
+		{"Tron bad checksum", coin.TRON, "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6u", ErrInvalidChecksum},
+		{"Cosmos", coin.COSMOS, "cosmos1hsk6jryyqjfhp5dhc55tc9jtckygx0eph6dd02", nil},
+		{"Cosmos address on Osmosis", coin.OSMOSIS, "cosmos1hsk6jryyqjfhp5dhc55tc9jtckygx0eph6dd02", ErrInvalidHRP},
+		{"Cosmos bad checksum", coin.COSMOS, "cosmos1hsk6jryyqjfhp5dhc55tc9jtckygx0eph6dd03", ErrInvalidChecksum},
+		{"Cosmos mixed case", coin.COSMOS, "cosmos1hsk6jryyqjfhp5dhc55tc9jtckygx0eph6dD02", ErrInvalidBech32},
+		{"Binance", coin.BINANCE, "bnb1grpf0955h0ykzq3ar5nmum7y6gdfl6lxfn46h2", nil},
+		{"Harmony", coin.HARMONY, "one1a50tun737ulcvwy0yvve0pvu5skq0kjargvhwe", nil},
+		{"Zilliqa", coin.ZILLIQA, "zil1fwh4ltdguhde9s7nysnp33d5wye6uqpugufkz7", nil},
+		{"IoTeX", coin.IOTEX, "io1nyjs526mnqcsx4twa7nptkg08eclsw5c2dywp4", nil},
+		{"Thorchain", coin.THORCHAIN, "thor1z53wwe7md6cewz9sqwqzn0aavpaun0gw0exn2r", nil},
+		{"Elrond", coin.ELROND, "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th", nil},
+		{"Oasis", coin.OASIS, "oasis1qp0302fv0gz858azasg663ax2epakk5fcssgza7j", nil},
+		{"Solana", coin.SOLANA, "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", nil},
+		{"Solana short", coin.SOLANA, "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTD", ErrInvalidLength},
+		{"Ripple", coin.RIPPLE, "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", nil},
+		{"Ripple X-address", coin.RIPPLE, "XVLhHMPHU98es4dbozjVtdWzVrDjtV18pX8yuPT7y4xaEHi", nil},
+		{"Ripple bad checksum", coin.RIPPLE, "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTk", ErrInvalidChecksum},
+		{"Stellar", coin.STELLAR, "GAI3GJ2Q3B35AOZJ36C4ANE3HSS4NK7WI6DNO4ZSHRAX6NG7BMX6VJER", nil},
+		{"Stellar bad checksum", coin.STELLAR, "GAI3GJ2Q3B35AOZJ36C4ANE3HSS4NK7WI6DNO4ZSHRAX6NG7BMX6VJEQ", ErrInvalidChecksum},
+		{"Kin", coin.KIN, "GBDUPSZP4APH3PNFIMYMTHIGCQQ2GKTPRBDTPCORALYRYJZJ35O2LOBL", nil},
+		{"Polkadot", coin.POLKADOT, "15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6Sp5", nil},
+		{"Polkadot address on Kusama", coin.KUSAMA, "15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6Sp5", ErrInvalidVersion},
+		{"Kusama", coin.KUSAMA, "HNZata7iMYWmk5RvZRTiAsSDhV8366zq2YGb3tLH5Upf74F", nil},
+		{"Algorand", coin.ALGORAND, "VCMJKWOY5P5P7SKMZFFOCEROPJCZOTIJMNIYNUCKH7LRO45JMJP6UYBIJA", nil},
+		{"Nano", coin.NANO, "nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3", nil},
+		{"Nano bad checksum", coin.NANO, "nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr4", ErrInvalidChecksum},
+		{"TON user-friendly", coin.TON, "EQCD39VS5jcptHL8vMjEXrzGaRcCVYto7HUn4bpAOg8xqB2N", nil},
+		{"TON raw", coin.TON, "0:83dfd552e63729b472fcbcc8c45ebcc6691702558b68ec7527e1ba403a0f31a8", nil},
+		{"TON bad checksum", coin.TON, "EQCD39VS5jcptHL8vMjEXrzGaRcCVYto7HUn4bpAOg8xqB2O", ErrInvalidChecksum},
+		{"Tezos", coin.TEZOS, "tz1KqTpEZ7Yob7QbPE4Hy4Wo8fHG8LhKxZSx", nil},
+		{"Waves", coin.WAVES, "3PAWwWa6GbwcJaFzwqXQN5KQm7H96Y7SHTQ", nil},
+		{"Filecoin secp256k1", coin.FILECOIN, "f17uoq6tp427uzv7fztkbsnn64iwotfrristwpryy", nil},
+		{"Filecoin ID", coin.FILECOIN, "f01024", nil},
+		{"Filecoin bad checksum", coin.FILECOIN, "f17uoq6tp427uzv7fztkbsnn64iwotfrristwpray", ErrInvalidChecksum},
+		{"Cardano Shelley", coin.CARDANO, "addr1qx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzer3n0d3vllmyqwsx5wktcd8cc3sq835lu7drv2xwl2wywfgse35a3x", nil},
+		{"Cardano Byron", coin.CARDANO, "Ae2tdPwUPEZFRbyhz3cpfC2CumGzNkFBN2L42rcUc2yjQpEkxDbkPodpMAi", nil},
+		{"Aeternity", coin.AETERNITY, "ak_2a1j2Mk9YSmC1gioUq4PWRm3bsv887MbuRVwyv4KaUGoR1eiKi", nil},
+		{"Nimiq", coin.NIMIQ, "NQ07 0000 0000 0000 0000 0000 0000 0000 0000", nil},
+		{"Nimiq bad checksum", coin.NIMIQ, "NQ08 0000 0000 0000 0000 0000 0000 0000 0000", ErrInvalidChecksum},
+		{"Ontology", coin.ONTOLOGY, "AXK2KtCfcJnSMyRzSwTuwTKgNrtx5aXfFX", nil},
+		{"NEO legacy", coin.NEO, "AKkkumHbBipZ46UMZJoFynJMXzSRnBvKcs", nil},
+		{"NEO N3", coin.NEO, "NiNmXL8FjEUEs1nfX9uHFBNaenxDHJtmuB", nil},
+		{"NULS", coin.NULS, "NULSd6HgWabfcG6H7NDK2TJvtoU3wxY1YLKwJ", nil},
+		{"NULS bad checksum", coin.NULS, "NULSd6HgdLpvZuk6UjSPnPVGyyKXtjWufoMfT", ErrInvalidChecksum},
+		{"FIO", coin.FIO, "FIO5kJKNHwctcfUM5XZyiWSqSTM5HTzznJP9F3ZdbhaQAHEVq575o", nil},
+		{"Nebulas", coin.NEBULAS, "n1Z6SbjLuAEXfhX1UJvXT6BB5osWYxVg3F3", nil},
+		{"Internet Computer principal", coin.INTERNET_COMPUTER, "rrkah-fqaaa-aaaaa-aaaaq-cai", nil},
+		{"Internet Computer bad account", coin.INTERNET_COMPUTER, "a2d8e3d2b49e5ffcd5c4d9ab2a7b5e2dd5f7b1d3a4d8b0c9c6e5d8e6f4a5c3b2", ErrInvalidChecksum},
+		{"NEAR named", coin.NEAR, "alice.near", nil},
+		{"NEAR implicit", coin.NEAR, "98793cd91a3f870fb126f66285808c7e094afcfc4eda8a970f6648cdf0dbd6de", nil},
+		{"NEAR upper case", coin.NEAR, "Alice.near", ErrInvalidCharacter},
+		{"EOS", coin.EOS, "eosio.token", nil},
+		{"EOS too long", coin.EOS, "eosiotokenabcd", ErrInvalidLength},
+		{"Icon", coin.ICON, "hx116f042497e5f34268b1b91e742680f84cf4e9f3", nil},
+		{"Aion", coin.AION, "0xa0d2312facea71b740679c926d040c9056a65a4bfa2ddd18ec160064f82909e7", nil},
+		{"Aptos short", coin.APTOS, "0x1", nil},
+		{"Sui", coin.SUI, "0x0000000000000000000000000000000000000000000000000000000000000002", nil},
+		{"Sui too long", coin.SUI, "0x00000000000000000000000000000000000000000000000000000000000000002", ErrInvalidLength},
+		{"Empty address", coin.ETHEREUM, "", ErrEmptyAddress},
+		{"Unknown coin", 123456789, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", ErrUnsupportedCoin},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.coinID, tt.addr)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			var validationErr *ValidationError
+			require.True(t, errors.As(err, &validationErr))
+			assert.Equal(t, tt.coinID, validationErr.CoinID)
+		})
+	}
+}
+
+// TestValidateCoversAllCoins fails when a coin with a new blockchain family is added to coins.yml
+func TestValidateCoversAllCoins(t *testing.T) {
+	for _, c := range coin.Coins {
+		err := Validate(c.ID, "?")
+		assert.Falsef(t, errors.Is(err, ErrUnsupportedCoin), "chain: %s", c.Handle)
+	}
+}
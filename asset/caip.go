@@ -0,0 +1,86 @@
+package asset
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/trustwallet/go-primitives/coin"
+)
+
+const caip19NamespaceSLIP44 = "slip44"
+
+var ErrUnsupportedCAIP19 = errors.New("unsupported CAIP-19 asset type")
+
+// caip19TokenNamespaces maps a CAIP-2 namespace to the CAIP-19 namespace of its tokens.
+var caip19TokenNamespaces = map[string]string{
+	coin.CAIP2NamespaceEIP155: "erc20",
+	coin.CAIP2NamespaceSolana: "token",
+	coin.CAIP2NamespaceTron:   "trc20",
+}
+
+// ToCAIP19 returns the CAIP-19 asset type of the ID, e.g. "eip155:1/slip44:60" for a coin
+// or "eip155:1/erc20:0x..." for a token.
+func ToCAIP19(id ID) (string, error) {
+	if id.IsZero() {
+		return "", ErrBadAssetID
+	}
+	c, ok := coin.Coins[id.coinID]
+	if !ok {
+		return "", ErrUnknownCoin
+	}
+	chainID, err := c.CAIP2()
+	if err != nil {
+		return "", err
+	}
+
+	if id.IsCollectible() {
+		return "", fmt.Errorf("%w: collectible %s", ErrUnsupportedCAIP19, id)
+	}
+	if !id.IsToken() {
+		slip44, ok := c.SLIP44()
+		if !ok {
+			return "", fmt.Errorf("%w: native asset of %s", ErrUnsupportedCAIP19, c.Handle)
+		}
+		return chainID + "/" + caip19NamespaceSLIP44 + ":" + strconv.FormatUint(uint64(slip44), 10), nil
+	}
+
+	namespace, _, _ := strings.Cut(chainID, ":")
+	tokenNamespace, ok := caip19TokenNamespaces[namespace]
+	if !ok {
+		return "", fmt.Errorf("%w: tokens of %s", ErrUnsupportedCAIP19, chainID)
+	}
+	return chainID + "/" + tokenNamespace + ":" + id.tokenID, nil
+}
+
+// FromCAIP19 parses a CAIP-19 asset type. Native assets must use the slip44 reference
+// of the chain's native asset, token IDs are returned canonicalized.
+func FromCAIP19(assetType string) (ID, error) {
+	chainID, assetPart, ok := strings.Cut(assetType, "/")
+	if !ok {
+		return ID{}, fmt.Errorf("%w: %s", ErrUnsupportedCAIP19, assetType)
+	}
+	c, err := coin.GetCoinByCAIP2(chainID)
+	if err != nil {
+		return ID{}, err
+	}
+
+	namespace, reference, ok := strings.Cut(assetPart, ":")
+	if !ok || reference == "" {
+		return ID{}, fmt.Errorf("%w: %s", ErrUnsupportedCAIP19, assetType)
+	}
+	if namespace == caip19NamespaceSLIP44 {
+		slip44, ok := c.SLIP44()
+		if !ok || reference != strconv.FormatUint(uint64(slip44), 10) {
+			return ID{}, fmt.Errorf("%w: %s", ErrUnsupportedCAIP19, assetType)
+		}
+		return NewCoinID(c.ID), nil
+	}
+
+	chainNamespace, _, _ := strings.Cut(chainID, ":")
+	if caip19TokenNamespaces[chainNamespace] != namespace {
+		return ID{}, fmt.Errorf("%w: %s", ErrUnsupportedCAIP19, assetType)
+	}
+	return NewTokenID(c.ID, reference).Canonical(), nil
+}
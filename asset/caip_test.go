@@ -0,0 +1,74 @@
+package asset
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/trustwallet/go-primitives/coin"
+)
+
+func TestToCAIP19(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      ID
+		want    string
+		wantErr error
+	}{
+		{"ether", NewCoinID(coin.ETHEREUM), "eip155:1/slip44:60", nil},
+		{"bnb on smart chain", NewCoinID(coin.SMARTCHAIN), "eip155:56/slip44:714", nil},
+		{"ether on optimism", NewCoinID(coin.OPTIMISM), "eip155:10/slip44:60", nil},
+		{"ether on arbitrum", NewCoinID(coin.ARBITRUM), "eip155:42161/slip44:60", nil},
+		{"ether on base", NewCoinID(coin.BASE), "eip155:8453/slip44:60", nil},
+		{"atom", NewCoinID(coin.COSMOS), "cosmos:cosmoshub-4/slip44:118", nil},
+		{"osmo without slip44", NewCoinID(coin.OSMOSIS), "", ErrUnsupportedCAIP19},
+		{"erc20", NewTokenID(coin.ETHEREUM, "0xdAC17F958D2ee523a2206206994597C13D831ec7"), "eip155:1/erc20:0xdAC17F958D2ee523a2206206994597C13D831ec7", nil},
+		{"spl", NewTokenID(coin.SOLANA, "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v"), "solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp/token:EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", nil},
+		{"trc20", NewTokenID(coin.TRON, "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t"), "tron:0x2b6653dc/trc20:TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", nil},
+		{"collectible", NewCollectibleID(coin.ETHEREUM, "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d", "1234"), "", ErrUnsupportedCAIP19},
+		{"cosmos token", NewTokenID(coin.COSMOS, "uatom"), "", ErrUnsupportedCAIP19},
+		{"unsupported chain", NewCoinID(coin.NIMIQ), "", coin.ErrUnsupportedCAIP2},
+		{"unknown coin", NewCoinID(123456), "", ErrUnknownCoin},
+		{"zero", ID{}, "", ErrBadAssetID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ToCAIP19(tt.id)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFromCAIP19(t *testing.T) {
+	tests := []struct {
+		name      string
+		assetType string
+		want      ID
+		wantErr   error
+	}{
+		{"ether", "eip155:1/slip44:60", NewCoinID(coin.ETHEREUM), nil},
+		{"ether on optimism", "eip155:10/slip44:60", NewCoinID(coin.OPTIMISM), nil},
+		{"erc20 lower case", "eip155:1/erc20:0xdac17f958d2ee523a2206206994597c13d831ec7", NewTokenID(coin.ETHEREUM, "0xdAC17F958D2ee523a2206206994597C13D831ec7"), nil},
+		{"spl", "solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp/token:EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", NewTokenID(coin.SOLANA, "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v"), nil},
+		{"wrong token namespace", "eip155:1/spl:0xdac17f958d2ee523a2206206994597c13d831ec7", ID{}, ErrUnsupportedCAIP19},
+		{"ether on arbitrum", "eip155:42161/slip44:60", NewCoinID(coin.ARBITRUM), nil},
+		{"bnb on smart chain", "eip155:56/slip44:714", NewCoinID(coin.SMARTCHAIN), nil},
+		{"invalid slip44", "eip155:1/slip44:eth", ID{}, ErrUnsupportedCAIP19},
+		{"slip44 of another asset", "eip155:1/slip44:714", ID{}, ErrUnsupportedCAIP19},
+		{"stripped derived slip44", "eip155:10/slip44:70", ID{}, ErrUnsupportedCAIP19},
+		{"native without slip44", "cosmos:osmosis-1/slip44:118", ID{}, ErrUnsupportedCAIP19},
+		{"no asset", "eip155:1", ID{}, ErrUnsupportedCAIP19},
+		{"empty reference", "eip155:1/erc20:", ID{}, ErrUnsupportedCAIP19},
+		{"unknown chain", "eip155:999999999/slip44:60", ID{}, coin.ErrUnsupportedCAIP2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromCAIP19(tt.assetType)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package asset
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/trustwallet/go-primitives/address"
+	"github.com/trustwallet/go-primitives/coin"
+)
+
+// moveAddressSize is the hex length of a 32 byte Aptos or Sui address
+const moveAddressSize = 64
+
+var (
+	evmTokenRegexp = regexp.MustCompile(`^0[xX][0-9a-fA-F]{40}$`)
+	moveHexRegexp  = regexp.MustCompile(`\b0[xX][0-9a-fA-F]{1,64}\b`)
+)
+
+// Canonicalize parses the asset ID strictly and returns it with the token part in the
+// canonical form of the coin's blockchain, so equal assets always produce the same ID:
+//   - EVM contract addresses are checksummed (EIP55, or EIP1191 where the coin uses it)
+//   - Aptos and Sui addresses inside move types are lower-case and zero-padded to 32 bytes
+//
+// Tokens of other blockchains, e.g. Solana mints or Tron contracts, are case sensitive
+// and returned unchanged.
+func Canonicalize(id string) (string, error) {
+	parsed, err := ParseStrict(id)
+	if err != nil {
+		return "", err
+	}
+	return parsed.Canonical().String(), nil
+}
+
+// Canonical returns the ID with its token part canonicalized as described in Canonicalize.
+func (id ID) Canonical() ID {
+	if id.tokenID == "" {
+		return id
+	}
+	return NewCollectibleID(id.coinID, canonicalTokenID(id.coinID, id.tokenID), id.collectibleID)
+}
+
+func canonicalTokenID(coinID uint, tokenID string) string {
+	c := coin.Coins[coinID]
+	switch c.Blockchain {
+	case coin.BlockchainEthereum, coin.BlockchainTheta, coin.BlockchainVechain, coin.BlockchainGreenfield:
+		if !evmTokenRegexp.MatchString(tokenID) {
+			return tokenID
+		}
+		checksummed, err := address.ChecksumByCoin(c, tokenID)
+		if err != nil {
+			return tokenID
+		}
+		return checksummed
+	case coin.BlockchainAptos, coin.BlockchainSui:
+		return moveHexRegexp.ReplaceAllStringFunc(tokenID, func(hex string) string {
+			body := strings.ToLower(hex[2:])
+			return "0x" + strings.Repeat("0", moveAddressSize-len(body)) + body
+		})
+	}
+	return tokenID
+}
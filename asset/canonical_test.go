@@ -0,0 +1,53 @@
+package asset
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanonicalize(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		want    string
+		wantErr error
+	}{
+		{"coin", "c60", "c60", nil},
+		{"erc20 lower case", "c60_t0xdac17f958d2ee523a2206206994597c13d831ec7", "c60_t0xdAC17F958D2ee523a2206206994597C13D831ec7", nil},
+		{"erc20 checksummed", "c60_t0xdAC17F958D2ee523a2206206994597C13D831ec7", "c60_t0xdAC17F958D2ee523a2206206994597C13D831ec7", nil},
+		{"erc20 upper case", "c60_t0XDAC17F958D2EE523A2206206994597C13D831EC7", "c60_t0xdAC17F958D2ee523a2206206994597C13D831ec7", nil},
+		{"bep20", "c20000714_t0x55d398326f99059ff775485246999027b3197955", "c20000714_t0x55d398326f99059fF775485246999027B3197955", nil},
+		{"erc721 collectible", "c60_t0x06012c8cf97bead5deae237070f9587f8e7a266d_n1234", "c60_t0x06012c8cf97BEaD5deAe237070F9587f8E7A266d_n1234", nil},
+		{"aptos short address", "c637_t0x1::aptos_coin::AptosCoin", "c637_t0x0000000000000000000000000000000000000000000000000000000000000001::aptos_coin::AptosCoin", nil},
+		{"aptos padded", "c637_t0x0000000000000000000000000000000000000000000000000000000000000001::aptos_coin::AptosCoin", "c637_t0x0000000000000000000000000000000000000000000000000000000000000001::aptos_coin::AptosCoin", nil},
+		{"sui upper case with type argument", "c784_t0x2::coin::Coin<0xABC::usdc::USDC>", "c784_t0x0000000000000000000000000000000000000000000000000000000000000002::coin::Coin<0x0000000000000000000000000000000000000000000000000000000000000abc::usdc::USDC>", nil},
+		{"solana case preserving", "c501_tEPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", "c501_tEPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", nil},
+		{"tron case preserving", "c195_tTR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", "c195_tTR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", nil},
+		{"binance symbol", "c714_tTWT-8C2", "c714_tTWT-8C2", nil},
+		{"ton jetton with underscore", "c607_tEQCxE6mUtQJKFnGfaROTKOt1lZbDiiX1kCixRv7Nw2Id_sDs", "c607_tEQCxE6mUtQJKFnGfaROTKOt1lZbDiiX1kCixRv7Nw2Id_sDs", nil},
+		{"reversed", "t0xdac17f958d2ee523a2206206994597c13d831ec7_c60", "", ErrReversedAssetID},
+		{"unknown coin", "c123456_tabc", "", ErrUnknownCoin},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Canonicalize(tt.id)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestIDCanonical(t *testing.T) {
+	assert.Equal(t, NewCoinID(60), NewCoinID(60).Canonical())
+	assert.Equal(t, ID{}, ID{}.Canonical())
+	assert.Equal(t,
+		NewTokenID(60, "0xdAC17F958D2ee523a2206206994597C13D831ec7"),
+		NewTokenID(60, "0xdac17f958d2ee523a2206206994597c13d831ec7").Canonical(),
+	)
+}
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"strconv"
 	"strings"
+
+	"github.com/trustwallet/go-primitives/coin"
 )
 
 var ErrBadAssetID = errors.New("bad ID")
@@ -11,11 +13,13 @@ var ErrBadAssetID = errors.New("bad ID")
 type CoinType string
 
 const (
-	Coin  CoinType = "coin"
-	Token CoinType = "token"
+	Coin        CoinType = "coin"
+	Token       CoinType = "token"
+	Collectible CoinType = "collectible"
 
-	coinPrefix  = 'c'
-	tokenPrefix = 't'
+	coinPrefix        = 'c'
+	tokenPrefix       = 't'
+	collectiblePrefix = 'n'
 )
 
 func ParseID(id string) (uint, string, error) {
@@ -47,6 +51,52 @@ func BuildID(coin uint, token string) string {
 	return string(coinPrefix) + c
 }
 
+// BuildCollectibleID returns the ID of a single NFT, e.g. "c60_t0xcontract_n1234".
+// Without a token or collectible it falls back to BuildID.
+func BuildCollectibleID(coin uint, token, collectible string) string {
+	id := BuildID(coin, token)
+	if token != "" && collectible != "" {
+		return id + "_" + string(collectiblePrefix) + collectible
+	}
+	return id
+}
+
+// ParseCollectibleID parses an ID built by BuildCollectibleID.
+// The collectible is empty for coin and token IDs. A trailing "_n<collectible>" is only read as
+// a collectible on EVM coins, on other coins it stays part of the token, e.g. the NEAR account
+// "usdt_native.near".
+func ParseCollectibleID(id string) (uint, string, string, error) {
+	coinID, token, err := ParseID(id)
+	if err != nil {
+		return 0, "", "", err
+	}
+	token, collectible := splitCollectible(coinID, token)
+	return coinID, token, collectible, nil
+}
+
+// hasCollectibles reports whether the coin has collectible IDs. Collectibles are the ERC-721 and
+// ERC-1155 items of EVM coins, whose hex contract tokens never contain '_', so the "_n" separator
+// cannot be part of their token.
+func hasCollectibles(coinID uint) bool {
+	return coin.IsEVM(coinID)
+}
+
+// splitCollectible cuts the trailing "_n<collectible>" segment off the token of a coin with collectibles.
+func splitCollectible(coinID uint, token string) (string, string) {
+	if !hasCollectibles(coinID) {
+		return token, ""
+	}
+	sep := strings.LastIndex(token, "_"+string(collectiblePrefix))
+	if sep < 0 {
+		return token, ""
+	}
+	collectible := token[sep+2:]
+	if collectible == "" || strings.ContainsAny(collectible, "_:") {
+		return token, ""
+	}
+	return token[:sep], collectible
+}
+
 func FindCoinID(words []string) (uint, error) {
 	for _, w := range words {
 		if len(w) == 0 {
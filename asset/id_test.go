@@ -122,6 +122,70 @@ func TestBuildID(t *testing.T) {
 	}
 }
 
+func TestBuildCollectibleID(t *testing.T) {
+	tests := []struct {
+		name        string
+		coin        uint
+		token       string
+		collectible string
+		wantedID    string
+	}{
+		{"erc721", 60, "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d", "1234", "c60_t0x06012c8cf97BEaD5deAe237070F9587f8E7A266d_n1234"},
+		{"erc1155 hex id", 137, "0x2953399124F0cBB46d2CbACD8A89cF0599974963", "0x1f", "c137_t0x2953399124F0cBB46d2CbACD8A89cF0599974963_n0x1f"},
+		{"token only", 60, "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d", "", "c60_t0x06012c8cf97BEaD5deAe237070F9587f8E7A266d"},
+		{"coin only", 60, "", "1234", "c60"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantedID, BuildCollectibleID(tt.coin, tt.token, tt.collectible))
+		})
+	}
+}
+
+func TestParseCollectibleID(t *testing.T) {
+	tests := []struct {
+		name              string
+		givenID           string
+		wantedCoin        uint
+		wantedToken       string
+		wantedCollectible string
+		wantedError       error
+	}{
+		{"erc721", "c60_t0x06012c8cf97BEaD5deAe237070F9587f8E7A266d_n1234", 60, "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d", "1234", nil},
+		{"token", "c714_tTWT-8C2", 714, "TWT-8C2", "", nil},
+		{"coin", "c60", 60, "", "", nil},
+		{"collectible without token", "c60_n1234", 60, "", "", nil},
+		{"empty collectible", "c60_t0x06012c8cf97BEaD5deAe237070F9587f8E7A266d_n", 60, "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d_n", "", nil},
+		{"move type with underscore", "c637_t0x1::coin_nft::Name", 637, "0x1::coin_nft::Name", "", nil},
+		{"move type ending like a collectible", "c637_t0x1::coin::Name_nX", 637, "0x1::coin::Name_nX", "", nil},
+		{"near account", "c397_tusdt_native.near", 397, "usdt_native.near", "", nil},
+		{"binance composite token", "c714_tPOOL_nLP-1", 714, "POOL_nLP-1", "", nil},
+		{"no coin", "t0x06012c8cf97BEaD5deAe237070F9587f8E7A266d_n1234", 0, "", "", ErrBadAssetID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			coin, token, collectible, err := ParseCollectibleID(tt.givenID)
+			assert.Equal(t, tt.wantedCoin, coin)
+			assert.Equal(t, tt.wantedToken, token)
+			assert.Equal(t, tt.wantedCollectible, collectible)
+			assert.Equal(t, tt.wantedError, err)
+		})
+	}
+}
+
+func TestCollectibleIDRoundTrip(t *testing.T) {
+	for _, collectible := range []string{"0", "1234", "0x1f", "115792089237316195423570985008687907853269984665640564039457584007913129639935"} {
+		id := BuildCollectibleID(60, "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d", collectible)
+		coin, token, parsed, err := ParseCollectibleID(id)
+		assert.NoError(t, err)
+		assert.Equal(t, uint(60), coin)
+		assert.Equal(t, "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d", token)
+		assert.Equal(t, collectible, parsed)
+	}
+}
+
 func Test_removeFirstChar(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -1,21 +1,14 @@
 package asset
 
-import (
-	"fmt"
-
-	"github.com/trustwallet/go-primitives/coin"
-)
-
+// GetImageURL returns the logo URL of the asset, or an empty string if the asset is invalid.
 func GetImageURL(endpoint, asset string) string {
-	coinId, tokenId, err := ParseID(asset)
+	id, err := Parse(asset)
 	if err != nil {
 		return ""
 	}
-	if c, ok := coin.Coins[coinId]; ok {
-		if len(tokenId) > 0 {
-			return fmt.Sprintf("%s/blockchains/%s/assets/%s/logo.png", endpoint, c.Handle, tokenId)
-		}
-		return fmt.Sprintf("%s/blockchains/%s/info/logo.png", endpoint, c.Handle)
+	url, err := NewURLBuilder(endpoint).LogoURL(id, ImageOptions{})
+	if err != nil {
+		return ""
 	}
-	return ""
+	return url
 }
@@ -0,0 +1,139 @@
+package asset
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/trustwallet/go-primitives/coin"
+)
+
+var (
+	ErrEmptyAssetID       = errors.New("empty asset ID")
+	ErrMissingCoinPrefix  = errors.New("asset ID must start with the c prefix")
+	ErrReversedAssetID    = errors.New("token segment precedes coin segment")
+	ErrInvalidCoinID      = errors.New("invalid coin ID")
+	ErrUnknownCoin        = errors.New("unknown coin")
+	ErrMissingTokenPrefix = errors.New("token segment must start with the t prefix")
+	ErrInvalidTokenID     = errors.New("invalid token ID")
+	ErrInvalidCollectible = errors.New("invalid collectible ID")
+	ErrTrailingData       = errors.New("trailing data after asset ID")
+)
+
+// ParseStrict parses an asset ID of the exact form "c<coin>", "c<coin>_t<token>" or
+// "c<coin>_t<token>_n<collectible>", collectibles only existing on EVM coins. Unlike ParseID it
+// rejects reversed segments, tokens without their prefix, coins missing from coin.Coins, extra
+// segments and trailing characters, and it reports each problem with its own error.
+func ParseStrict(id string) (ID, error) {
+	if id == "" {
+		return ID{}, fmt.Errorf("asset id %q: %w", id, ErrEmptyAssetID)
+	}
+	if strings.TrimRightFunc(id, unicode.IsSpace) != id {
+		return ID{}, fmt.Errorf("asset id %q: %w", id, ErrTrailingData)
+	}
+
+	coinID, tokenID, collectibleID, err := parseStrict(id)
+	if err != nil {
+		return ID{}, fmt.Errorf("asset id %q: %w", id, err)
+	}
+	return NewCollectibleID(coinID, tokenID, collectibleID), nil
+}
+
+func parseStrict(id string) (uint, string, string, error) {
+	coinSegment, tokenSegment, hasToken := strings.Cut(id, "_")
+	switch {
+	case coinSegment == "":
+		return 0, "", "", ErrMissingCoinPrefix
+	case coinSegment[0] == tokenPrefix:
+		return 0, "", "", ErrReversedAssetID
+	case coinSegment[0] != coinPrefix:
+		return 0, "", "", ErrMissingCoinPrefix
+	}
+
+	coinID, err := parseStrictCoin(coinSegment[1:])
+	if err != nil {
+		return 0, "", "", err
+	}
+
+	if !hasToken {
+		return coinID, "", "", nil
+	}
+	if tokenSegment == "" {
+		return 0, "", "", ErrTrailingData
+	}
+	if tokenSegment[0] != tokenPrefix {
+		return 0, "", "", ErrMissingTokenPrefix
+	}
+
+	token, collectible, err := cutStrictCollectible(coinID, tokenSegment[1:])
+	if err != nil {
+		return 0, "", "", err
+	}
+	if token == "" || strings.IndexFunc(token, isInvalidTokenRune) >= 0 {
+		return 0, "", "", ErrInvalidTokenID
+	}
+	if strings.Contains(token, "_") && plainTokenBlockchains[coin.Coins[coinID].Blockchain] {
+		return 0, "", "", ErrTrailingData
+	}
+	return coinID, token, collectible, nil
+}
+
+// cutStrictCollectible cuts the "_n<collectible>" segment off the token of a coin with collectibles,
+// rejecting an empty or malformed collectible and any other segment.
+func cutStrictCollectible(coinID uint, token string) (string, string, error) {
+	if !hasCollectibles(coinID) {
+		return token, "", nil
+	}
+	token, segment, ok := strings.Cut(token, "_")
+	if !ok {
+		return token, "", nil
+	}
+	if segment == "" || segment[0] != collectiblePrefix || strings.Contains(segment, "_") {
+		return "", "", ErrTrailingData
+	}
+	collectible := segment[1:]
+	if collectible == "" || strings.IndexFunc(collectible, isInvalidTokenRune) >= 0 {
+		return "", "", ErrInvalidCollectible
+	}
+	return token, collectible, nil
+}
+
+// plainTokenBlockchains are the blockchains whose token IDs never contain '_': hex contracts,
+// base58 mints and BEP2 symbols. On them an '_' after the token starts an extra segment.
+// Other blockchains keep '_' in the token, e.g. TON base64url jettons, the move type
+// "0x1::aptos_coin::AptosCoin" or the NEAR account "token_v2.near".
+var plainTokenBlockchains = map[string]bool{
+	coin.BlockchainEthereum: true,
+	coin.BlockchainBinance:  true,
+	coin.BlockchainSolana:   true,
+	coin.BlockchainTron:     true,
+	coin.BlockchainVechain:  true,
+	coin.BlockchainTheta:    true,
+}
+
+func parseStrictCoin(raw string) (uint, error) {
+	digits := strings.IndexFunc(raw, func(r rune) bool { return r < '0' || r > '9' })
+	switch {
+	case digits == 0, raw == "":
+		return 0, ErrInvalidCoinID
+	case digits > 0:
+		return 0, ErrTrailingData
+	case len(raw) > 1 && raw[0] == '0':
+		return 0, ErrInvalidCoinID
+	}
+
+	coinID, err := strconv.ParseUint(raw, 10, 32)
+	if err != nil {
+		return 0, ErrInvalidCoinID
+	}
+	if _, ok := coin.Coins[uint(coinID)]; !ok {
+		return 0, ErrUnknownCoin
+	}
+	return uint(coinID), nil
+}
+
+func isInvalidTokenRune(r rune) bool {
+	return unicode.IsSpace(r) || unicode.IsControl(r) || r == unicode.ReplacementChar
+}
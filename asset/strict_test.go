@@ -0,0 +1,79 @@
+package asset
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trustwallet/go-primitives/coin"
+)
+
+func TestParseStrict(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		want    ID
+		wantErr error
+	}{
+		{"coin", "c60", NewCoinID(coin.ETHEREUM), nil},
+		{"bitcoin", "c0", NewCoinID(coin.BITCOIN), nil},
+		{"token", "c714_tTWT-8C2", NewTokenID(coin.BINANCE, "TWT-8C2"), nil},
+		{"move token with underscores", "c637_t0x1::aptos_coin::AptosCoin", NewTokenID(coin.APTOS, "0x1::aptos_coin::AptosCoin"), nil},
+		{"collectible", "c60_t0x06012c8cf97BEaD5deAe237070F9587f8E7A266d_n1234", NewCollectibleID(coin.ETHEREUM, "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d", "1234"), nil},
+		{"move type with underscore", "c637_t0x1::coin_nft::Name", NewTokenID(coin.APTOS, "0x1::coin_nft::Name"), nil},
+		{"empty", "", ID{}, ErrEmptyAssetID},
+		{"reversed", "tTWT-8C2_c714", ID{}, ErrReversedAssetID},
+		{"token only", "tTWT-8C2", ID{}, ErrReversedAssetID},
+		{"no coin prefix", "714", ID{}, ErrMissingCoinPrefix},
+		{"leading separator", "_c714", ID{}, ErrMissingCoinPrefix},
+		{"token without prefix", "c714_TWT-8C2", ID{}, ErrMissingTokenPrefix},
+		{"empty coin", "c", ID{}, ErrInvalidCoinID},
+		{"negative coin", "c-60", ID{}, ErrInvalidCoinID},
+		{"leading zero", "c060", ID{}, ErrInvalidCoinID},
+		{"coin overflow", "c99999999999", ID{}, ErrInvalidCoinID},
+		{"unknown coin", "c123456", ID{}, ErrUnknownCoin},
+		{"empty token", "c60_t", ID{}, ErrInvalidTokenID},
+		{"token with space", "c60_t0xabc def", ID{}, ErrInvalidTokenID},
+		{"token with control character", "c60_t0xabc\x00def", ID{}, ErrInvalidTokenID},
+		{"empty collectible", "c60_t0x06012c8cf97BEaD5deAe237070F9587f8E7A266d_n", ID{}, ErrInvalidCollectible},
+		{"collectible with space", "c60_t0xabc_n12 34", ID{}, ErrInvalidCollectible},
+		{"collectible without token", "c60_n1234", ID{}, ErrMissingTokenPrefix},
+		{"trailing separator", "c60_", ID{}, ErrTrailingData},
+		{"trailing characters after coin", "c60abc", ID{}, ErrTrailingData},
+		{"trailing whitespace", "c60_t0xabc\n", ID{}, ErrTrailingData},
+		{"extra coin segment", "c714_tTWT-8C2_c714", ID{}, ErrTrailingData},
+		{"extra token segment", "c60_t0xabc_t0xdef", ID{}, ErrTrailingData},
+		{"extra segment after collectible", "c60_t0xabc_n1_n2", ID{}, ErrTrailingData},
+		{"extra empty segment", "c60_t0xabc_", ID{}, ErrTrailingData},
+		{"underscore in token", "c501_tEPjFWdd5_AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", ID{}, ErrTrailingData},
+		{"near account with underscore", "c397_ttoken_v2.near", NewTokenID(coin.NEAR, "token_v2.near"), nil},
+		{"near account containing the collectible separator", "c397_tusdt_native.near", NewTokenID(coin.NEAR, "usdt_native.near"), nil},
+		{"ton jetton containing the collectible separator", "c607_tEQCxE6mUtQJKFnGfaROTKOt1lZbDiiX1kCixRv7Nw2Id_nDs", NewTokenID(coin.TON, "EQCxE6mUtQJKFnGfaROTKOt1lZbDiiX1kCixRv7Nw2Id_nDs"), nil},
+		{"no collectible on binance", "c714_tPOOL_nLP-1", ID{}, ErrTrailingData},
+		{"ton jetton with underscore", "c607_tEQCxE6mUtQJKFnGfaROTKOt1lZbDiiX1kCixRv7Nw2Id_sDs", NewTokenID(coin.TON, "EQCxE6mUtQJKFnGfaROTKOt1lZbDiiX1kCixRv7Nw2Id_sDs"), nil},
+		{"tron token with underscore", "c195_tTR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t_c195", ID{}, ErrTrailingData},
+		{"sui type with underscores", "c784_t0x2::sui_coin::SUI_COIN", NewTokenID(coin.SUI, "0x2::sui_coin::SUI_COIN"), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseStrict(tt.id)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseStrictAcceptsBuiltIDs(t *testing.T) {
+	for _, c := range coin.Coins {
+		id := BuildID(c.ID, "TOKEN")
+		parsed, err := ParseStrict(id)
+		require.NoError(t, err, id)
+		assert.Equal(t, id, parsed.String())
+	}
+}
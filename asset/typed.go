@@ -0,0 +1,197 @@
+package asset
+
+import (
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/trustwallet/go-primitives/coin"
+)
+
+// ID is a parsed asset ID: a coin, a token issued on a coin, or a single collectible of a token.
+// Its text form is the one produced by BuildCollectibleID, e.g. "c60", "c714_tTWT-8C2" or "c60_t0xcontract_n1234".
+// UnmarshalText and Scan parse with ParseCollectible, so every ID decodes from its own text.
+type ID struct {
+	coinID        uint
+	tokenID       string
+	collectibleID string
+	kind          CoinType
+}
+
+// NewCoinID returns the ID of the native asset of the coin.
+func NewCoinID(coinID uint) ID {
+	return ID{coinID: coinID, kind: Coin}
+}
+
+// NewTokenID returns the ID of a token issued on the coin, or the coin ID if tokenID is empty.
+func NewTokenID(coinID uint, tokenID string) ID {
+	if tokenID == "" {
+		return NewCoinID(coinID)
+	}
+	return ID{coinID: coinID, tokenID: tokenID, kind: Token}
+}
+
+// NewCollectibleID returns the ID of a collectible of the token, or the token ID if collectibleID is empty.
+func NewCollectibleID(coinID uint, tokenID, collectibleID string) ID {
+	if tokenID == "" || collectibleID == "" {
+		return NewTokenID(coinID, tokenID)
+	}
+	return ID{coinID: coinID, tokenID: tokenID, collectibleID: collectibleID, kind: Collectible}
+}
+
+// Parse parses an asset ID with the same rules as ParseID. A trailing "_n<collectible>"
+// stays part of the token, since existing token IDs may contain "_n"; use ParseCollectible
+// or ParseStrict for collectible IDs.
+func Parse(id string) (ID, error) {
+	coinID, tokenID, err := ParseID(id)
+	if err != nil {
+		return ID{}, fmt.Errorf("asset id %q: %w", id, err)
+	}
+	return NewTokenID(coinID, tokenID), nil
+}
+
+// ParseCollectible parses an asset ID with the same rules as ParseCollectibleID, a trailing
+// "_n<collectible>" is only read as a collectible on coins with collectibles.
+func ParseCollectible(id string) (ID, error) {
+	coinID, tokenID, collectibleID, err := ParseCollectibleID(id)
+	if err != nil {
+		return ID{}, fmt.Errorf("asset id %q: %w", id, err)
+	}
+	return NewCollectibleID(coinID, tokenID, collectibleID), nil
+}
+
+// MustParse is like Parse but panics if the ID is invalid.
+func MustParse(id string) ID {
+	parsed, err := Parse(id)
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+// FromAssetID parses the asset ID of a coin.Coin, a transfer or a fee.
+func FromAssetID(id coin.AssetID) (ID, error) {
+	return Parse(string(id))
+}
+
+func (id ID) CoinID() uint {
+	return id.coinID
+}
+
+// TokenID returns the token part of the ID, empty for coins.
+// For collectibles it is the collection, e.g. the ERC-721 contract.
+func (id ID) TokenID() string {
+	return id.tokenID
+}
+
+// CollectibleID returns the collectible part of the ID, empty for coins and tokens.
+func (id ID) CollectibleID() string {
+	return id.collectibleID
+}
+
+func (id ID) Type() CoinType {
+	return id.kind
+}
+
+func (id ID) IsToken() bool {
+	return id.kind == Token
+}
+
+func (id ID) IsCollectible() bool {
+	return id.kind == Collectible
+}
+
+func (id ID) IsZero() bool {
+	return id.kind == ""
+}
+
+// Coin returns the ID of the coin the asset is issued on.
+func (id ID) Coin() ID {
+	if id.IsZero() {
+		return ID{}
+	}
+	return NewCoinID(id.coinID)
+}
+
+// Token returns the ID of the token a collectible belongs to, other IDs are returned unchanged.
+func (id ID) Token() ID {
+	if id.IsZero() {
+		return ID{}
+	}
+	return NewTokenID(id.coinID, id.tokenID)
+}
+
+// Validate checks that the coin is known and the token part matches the type.
+func (id ID) Validate() error {
+	if _, ok := coin.Coins[id.coinID]; !ok {
+		return ErrBadAssetID
+	}
+	switch id.kind {
+	case Coin:
+		if id.tokenID != "" || id.collectibleID != "" {
+			return ErrBadAssetID
+		}
+	case Token:
+		if id.tokenID == "" || id.collectibleID != "" {
+			return ErrBadAssetID
+		}
+	case Collectible:
+		if id.tokenID == "" || id.collectibleID == "" {
+			return ErrBadAssetID
+		}
+	default:
+		return ErrBadAssetID
+	}
+	return nil
+}
+
+func (id ID) String() string {
+	if id.IsZero() {
+		return ""
+	}
+	return BuildCollectibleID(id.coinID, id.tokenID, id.collectibleID)
+}
+
+// AssetID returns the ID in the string form used by coin.Coin and the types package.
+func (id ID) AssetID() coin.AssetID {
+	return coin.AssetID(id.String())
+}
+
+func (id ID) MarshalText() ([]byte, error) {
+	return []byte(id.String()), nil
+}
+
+func (id *ID) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*id = ID{}
+		return nil
+	}
+	parsed, err := ParseCollectible(string(text))
+	if err != nil {
+		return err
+	}
+	*id = parsed
+	return nil
+}
+
+// Value implements driver.Valuer, the zero ID is stored as NULL.
+func (id ID) Value() (driver.Value, error) {
+	if id.IsZero() {
+		return nil, nil
+	}
+	return id.String(), nil
+}
+
+// Scan implements sql.Scanner.
+func (id *ID) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*id = ID{}
+		return nil
+	case string:
+		return id.UnmarshalText([]byte(v))
+	case []byte:
+		return id.UnmarshalText(v)
+	default:
+		return fmt.Errorf("cannot scan %T into asset.ID", src)
+	}
+}
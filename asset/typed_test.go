@@ -0,0 +1,239 @@
+package asset
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trustwallet/go-primitives/coin"
+)
+
+var (
+	_ encoding.TextMarshaler   = ID{}
+	_ encoding.TextUnmarshaler = &ID{}
+	_ driver.Valuer            = ID{}
+	_ sql.Scanner              = &ID{}
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		want    ID
+		wantErr error
+	}{
+		{"coin", "c60", NewCoinID(coin.ETHEREUM), nil},
+		{"token", "c714_tTWT-8C2", NewTokenID(coin.BINANCE, "TWT-8C2"), nil},
+		{"empty token", "c60_t", NewCoinID(coin.ETHEREUM), nil},
+		{"bitcoin", "c0", NewCoinID(coin.BITCOIN), nil},
+		{"no coin", "tTWT-8C2", ID{}, ErrBadAssetID},
+		{"empty", "", ID{}, ErrBadAssetID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.id)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	assert.Panics(t, func() { MustParse("60") })
+}
+
+func TestID(t *testing.T) {
+	token := MustParse("c714_tTWT-8C2")
+	assert.Equal(t, uint(coin.BINANCE), token.CoinID())
+	assert.Equal(t, "TWT-8C2", token.TokenID())
+	assert.Equal(t, Token, token.Type())
+	assert.True(t, token.IsToken())
+	assert.Equal(t, "c714_tTWT-8C2", token.String())
+	assert.Equal(t, NewCoinID(coin.BINANCE), token.Coin())
+	assert.NoError(t, token.Validate())
+
+	native := NewCoinID(coin.BITCOIN)
+	assert.Equal(t, Coin, native.Type())
+	assert.False(t, native.IsToken())
+	assert.False(t, native.IsZero())
+	assert.Equal(t, "c0", native.String())
+
+	nft, err := ParseCollectible("c60_t0x06012c8cf97BEaD5deAe237070F9587f8E7A266d_n1234")
+	require.NoError(t, err)
+	assert.Equal(t, Collectible, nft.Type())
+	assert.True(t, nft.IsCollectible())
+	assert.False(t, nft.IsToken())
+	assert.Equal(t, "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d", nft.TokenID())
+	assert.Equal(t, "1234", nft.CollectibleID())
+	assert.Equal(t, NewTokenID(coin.ETHEREUM, "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d"), nft.Token())
+	assert.Equal(t, "c60_t0x06012c8cf97BEaD5deAe237070F9587f8E7A266d_n1234", nft.String())
+	assert.NoError(t, nft.Validate())
+	assert.Equal(t, token, token.Token())
+
+	assert.True(t, ID{}.IsZero())
+	assert.Equal(t, "", ID{}.String())
+	assert.Equal(t, ID{}, ID{}.Coin())
+	assert.Equal(t, ID{}, ID{}.Token())
+	assert.Equal(t, ErrBadAssetID, ID{}.Validate())
+	assert.Equal(t, ErrBadAssetID, NewCoinID(123456789).Validate())
+}
+
+func TestParseKeepsTokensContainingCollectibleSeparator(t *testing.T) {
+	tests := []struct {
+		name  string
+		id    string
+		token string
+	}{
+		{"erc721 style", "c60_t0x06012c8cf97BEaD5deAe237070F9587f8E7A266d_n1234", "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d_n1234"},
+		{"nft suffix", "c501_tCOLLECTION_nft", "COLLECTION_nft"},
+		{"composite token", "c714_tPOOL_nLP-1", "POOL_nLP-1"},
+		{"move type", "c637_t0x1::coin::Name_nX", "0x1::coin::Name_nX"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := Parse(tt.id)
+			require.NoError(t, err)
+			assert.Equal(t, Token, id.Type())
+			assert.Equal(t, tt.token, id.TokenID())
+			assert.Equal(t, "", id.CollectibleID())
+			assert.Equal(t, tt.id, id.String())
+
+			coinID, tokenID, err := ParseID(tt.id)
+			require.NoError(t, err)
+			assert.Equal(t, NewTokenID(coinID, tokenID), id)
+		})
+	}
+}
+
+func TestParseCollectible(t *testing.T) {
+	tests := []struct {
+		name string
+		id   string
+		want ID
+	}{
+		{"token", "c714_tTWT-8C2", NewTokenID(coin.BINANCE, "TWT-8C2")},
+		{"erc721", "c60_t0x06012c8cf97BEaD5deAe237070F9587f8E7A266d_n1234", NewCollectibleID(coin.ETHEREUM, "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d", "1234")},
+		{"near account", "c397_tusdt_native.near", NewTokenID(coin.NEAR, "usdt_native.near")},
+		{"binance composite token", "c714_tPOOL_nLP-1", NewTokenID(coin.BINANCE, "POOL_nLP-1")},
+		{"solana nft suffix", "c501_tCOLLECTION_nft", NewTokenID(coin.SOLANA, "COLLECTION_nft")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := ParseCollectible(tt.id)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, id)
+		})
+	}
+
+	_, err := ParseCollectible("tTWT-8C2")
+	assert.ErrorIs(t, err, ErrBadAssetID)
+}
+
+// TestIDRoundTrip makes sure every ID decodes from its own text and SQL value.
+func TestIDRoundTrip(t *testing.T) {
+	ids := []ID{
+		NewCoinID(coin.ETHEREUM),
+		NewTokenID(coin.BINANCE, "TWT-8C2"),
+		NewTokenID(coin.BINANCE, "POOL_nLP-1"),
+		NewTokenID(coin.NEAR, "usdt_native.near"),
+		NewTokenID(coin.TON, "EQCxE6mUtQJKFnGfaROTKOt1lZbDiiX1kCixRv7Nw2Id_sDs"),
+		NewCollectibleID(coin.ETHEREUM, "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d", "1234"),
+		NewCollectibleID(coin.SMARTCHAIN, "0x2953399124F0cBB46d2CbACD8A89cF0599974963", "0x1f"),
+	}
+	for _, id := range ids {
+		t.Run(id.String(), func(t *testing.T) {
+			text, err := id.MarshalText()
+			require.NoError(t, err)
+			var fromText ID
+			require.NoError(t, fromText.UnmarshalText(text))
+			assert.Equal(t, id, fromText)
+
+			value, err := id.Value()
+			require.NoError(t, err)
+			var fromValue ID
+			require.NoError(t, fromValue.Scan(value))
+			assert.Equal(t, id, fromValue)
+
+			data, err := json.Marshal(id)
+			require.NoError(t, err)
+			var fromJSON ID
+			require.NoError(t, json.Unmarshal(data, &fromJSON))
+			assert.Equal(t, id, fromJSON)
+		})
+	}
+}
+
+func TestFromAssetID(t *testing.T) {
+	c := coin.Coins[coin.ETHEREUM]
+
+	id, err := FromAssetID(c.TokenAssetID("0xdAC17F958D2ee523a2206206994597C13D831ec7"))
+	require.NoError(t, err)
+	assert.Equal(t, NewTokenID(coin.ETHEREUM, "0xdAC17F958D2ee523a2206206994597C13D831ec7"), id)
+	assert.Equal(t, c.TokenAssetID("0xdAC17F958D2ee523a2206206994597C13D831ec7"), id.AssetID())
+
+	id, err = FromAssetID(c.AssetID())
+	require.NoError(t, err)
+	assert.Equal(t, c.AssetID(), id.AssetID())
+}
+
+func TestIDJSON(t *testing.T) {
+	type balance struct {
+		Asset ID `json:"asset"`
+	}
+
+	in := balance{Asset: NewTokenID(coin.BINANCE, "TWT-8C2")}
+	data, err := json.Marshal(in)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"asset":"c714_tTWT-8C2"}`, string(data))
+
+	var out balance
+	require.NoError(t, json.Unmarshal(data, &out))
+	assert.Equal(t, in, out)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"asset":"714"}`), &out))
+}
+
+func TestIDSQL(t *testing.T) {
+	id := NewTokenID(coin.SOLANA, "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v")
+	value, err := id.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "c501_tEPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", value)
+
+	value, err = ID{}.Value()
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	tests := []struct {
+		name    string
+		src     interface{}
+		want    ID
+		wantErr bool
+	}{
+		{"string", "c501_tEPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", id, false},
+		{"bytes", []byte("c501_tEPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v"), id, false},
+		{"null", nil, ID{}, false},
+		{"invalid", "501", ID{}, true},
+		{"unsupported type", 501, ID{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got ID
+			err := got.Scan(tt.src)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package asset
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/trustwallet/go-primitives/coin"
+)
+
+type ImageFormat string
+
+const (
+	ImageFormatPNG  ImageFormat = "png"
+	ImageFormatWebP ImageFormat = "webp"
+	ImageFormatSVG  ImageFormat = "svg"
+)
+
+var ErrUnsupportedImage = errors.New("image variant requires a CDN transform")
+
+// ImageOptions selects a logo variant. The zero value is the original logo.png.
+type ImageOptions struct {
+	// Size is the requested width and height in pixels, 0 keeps the original size
+	Size uint
+	// Format is the requested image format, empty means ImageFormatPNG
+	Format ImageFormat
+}
+
+func (o ImageOptions) isOriginal() bool {
+	return o.Size == 0 && (o.Format == "" || o.Format == ImageFormatPNG)
+}
+
+// ImageTransform rewrites the URL of an original logo.png into the URL of a CDN variant.
+type ImageTransform func(url string, opts ImageOptions) string
+
+type URLBuilderOption func(*URLBuilder)
+
+// WithImageTransform enables sized and non-PNG logos through the CDN transform.
+func WithImageTransform(transform ImageTransform) URLBuilderOption {
+	return func(b *URLBuilder) {
+		b.transform = transform
+	}
+}
+
+// URLBuilder builds URLs of the assets repository layout:
+//
+//	<endpoint>/blockchains/<chain>/info/{logo.png,info.json}
+//	<endpoint>/blockchains/<chain>/assets/<token>/{logo.png,info.json}
+//	<endpoint>/blockchains/<chain>/{tokenlist.json,tokenlist-extended.json}
+type URLBuilder struct {
+	endpoint  string
+	transform ImageTransform
+}
+
+func NewURLBuilder(endpoint string, opts ...URLBuilderOption) *URLBuilder {
+	b := &URLBuilder{endpoint: strings.TrimSuffix(endpoint, "/")}
+	for _, opt := range opts {
+		opt(b)
+	}
+	return b
+}
+
+// LogoURL returns the logo of the asset, collectibles use the logo of their token.
+func (b *URLBuilder) LogoURL(id ID, opts ImageOptions) (string, error) {
+	dir, err := b.assetDir(id)
+	if err != nil {
+		return "", err
+	}
+	return b.image(dir+"/logo.png", opts)
+}
+
+// LogoFallbackURLs returns the logo URLs to try in order: the token logo followed by
+// the logo of its chain. Coins only have their chain logo.
+func (b *URLBuilder) LogoFallbackURLs(id ID, opts ImageOptions) ([]string, error) {
+	urls := make([]string, 0, 2)
+	if id.tokenID != "" {
+		tokenLogo, err := b.LogoURL(id.Token(), opts)
+		if err != nil {
+			return nil, err
+		}
+		urls = append(urls, tokenLogo)
+	}
+	chainLogo, err := b.LogoURL(id.Coin(), opts)
+	if err != nil {
+		return nil, err
+	}
+	return append(urls, chainLogo), nil
+}
+
+// InfoURL returns the info.json of the asset, collectibles use the info of their token.
+func (b *URLBuilder) InfoURL(id ID) (string, error) {
+	dir, err := b.assetDir(id)
+	if err != nil {
+		return "", err
+	}
+	return dir + "/info.json", nil
+}
+
+// TokenListURL returns the tokenlist.json of the chain.
+func (b *URLBuilder) TokenListURL(coinID uint) (string, error) {
+	dir, err := b.chainDir(coinID)
+	if err != nil {
+		return "", err
+	}
+	return dir + "/tokenlist.json", nil
+}
+
+// ExtendedTokenListURL returns the tokenlist-extended.json of the chain.
+func (b *URLBuilder) ExtendedTokenListURL(coinID uint) (string, error) {
+	dir, err := b.chainDir(coinID)
+	if err != nil {
+		return "", err
+	}
+	return dir + "/tokenlist-extended.json", nil
+}
+
+func (b *URLBuilder) image(url string, opts ImageOptions) (string, error) {
+	if opts.isOriginal() {
+		return url, nil
+	}
+	if b.transform == nil {
+		return "", fmt.Errorf("%w: size %d, format %q", ErrUnsupportedImage, opts.Size, opts.Format)
+	}
+	if opts.Format == "" {
+		opts.Format = ImageFormatPNG
+	}
+	return b.transform(url, opts), nil
+}
+
+func (b *URLBuilder) assetDir(id ID) (string, error) {
+	if id.IsZero() {
+		return "", ErrBadAssetID
+	}
+	dir, err := b.chainDir(id.coinID)
+	if err != nil {
+		return "", err
+	}
+	if id.tokenID != "" {
+		return dir + "/assets/" + id.tokenID, nil
+	}
+	return dir + "/info", nil
+}
+
+func (b *URLBuilder) chainDir(coinID uint) (string, error) {
+	c, ok := coin.Coins[coinID]
+	if !ok {
+		return "", fmt.Errorf("%w: %d", ErrUnknownCoin, coinID)
+	}
+	return b.endpoint + "/blockchains/" + c.Handle, nil
+}
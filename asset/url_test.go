@@ -0,0 +1,88 @@
+package asset
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trustwallet/go-primitives/coin"
+)
+
+func TestURLBuilder_LogoURL(t *testing.T) {
+	cdn := NewURLBuilder("https://assets.com/", WithImageTransform(func(url string, opts ImageOptions) string {
+		return fmt.Sprintf("%s?w=%d&fm=%s", url, opts.Size, opts.Format)
+	}))
+
+	tests := []struct {
+		name    string
+		builder *URLBuilder
+		id      ID
+		opts    ImageOptions
+		want    string
+		wantErr error
+	}{
+		{"coin", NewURLBuilder("https://assets.com"), NewCoinID(coin.ETHEREUM), ImageOptions{}, "https://assets.com/blockchains/ethereum/info/logo.png", nil},
+		{"token", NewURLBuilder("https://assets.com"), NewTokenID(coin.BINANCE, "TWT-8C2"), ImageOptions{}, "https://assets.com/blockchains/binance/assets/TWT-8C2/logo.png", nil},
+		{"collectible uses token", NewURLBuilder("https://assets.com"), NewCollectibleID(coin.ETHEREUM, "0xabc", "1"), ImageOptions{}, "https://assets.com/blockchains/ethereum/assets/0xabc/logo.png", nil},
+		{"explicit png", NewURLBuilder("https://assets.com"), NewCoinID(coin.ETHEREUM), ImageOptions{Format: ImageFormatPNG}, "https://assets.com/blockchains/ethereum/info/logo.png", nil},
+		{"webp through cdn", cdn, NewCoinID(coin.ETHEREUM), ImageOptions{Size: 64, Format: ImageFormatWebP}, "https://assets.com/blockchains/ethereum/info/logo.png?w=64&fm=webp", nil},
+		{"size through cdn", cdn, NewCoinID(coin.ETHEREUM), ImageOptions{Size: 128}, "https://assets.com/blockchains/ethereum/info/logo.png?w=128&fm=png", nil},
+		{"svg without cdn", NewURLBuilder("https://assets.com"), NewCoinID(coin.ETHEREUM), ImageOptions{Format: ImageFormatSVG}, "", ErrUnsupportedImage},
+		{"unknown coin", NewURLBuilder("https://assets.com"), NewCoinID(123), ImageOptions{}, "", ErrUnknownCoin},
+		{"zero id", NewURLBuilder("https://assets.com"), ID{}, ImageOptions{}, "", ErrBadAssetID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.builder.LogoURL(tt.id, tt.opts)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestURLBuilder_LogoFallbackURLs(t *testing.T) {
+	b := NewURLBuilder("https://assets.com")
+
+	urls, err := b.LogoFallbackURLs(NewTokenID(coin.SMARTCHAIN, "0x55d398326f99059fF775485246999027B3197955"), ImageOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"https://assets.com/blockchains/smartchain/assets/0x55d398326f99059fF775485246999027B3197955/logo.png",
+		"https://assets.com/blockchains/smartchain/info/logo.png",
+	}, urls)
+
+	urls, err = b.LogoFallbackURLs(NewCoinID(coin.SMARTCHAIN), ImageOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"https://assets.com/blockchains/smartchain/info/logo.png"}, urls)
+
+	_, err = b.LogoFallbackURLs(NewCoinID(coin.SMARTCHAIN), ImageOptions{Format: ImageFormatWebP})
+	assert.ErrorIs(t, err, ErrUnsupportedImage)
+}
+
+func TestURLBuilder_Metadata(t *testing.T) {
+	b := NewURLBuilder("https://assets.com")
+
+	info, err := b.InfoURL(NewCoinID(coin.SOLANA))
+	require.NoError(t, err)
+	assert.Equal(t, "https://assets.com/blockchains/solana/info/info.json", info)
+
+	info, err = b.InfoURL(NewTokenID(coin.SOLANA, "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v"))
+	require.NoError(t, err)
+	assert.Equal(t, "https://assets.com/blockchains/solana/assets/EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v/info.json", info)
+
+	list, err := b.TokenListURL(coin.SOLANA)
+	require.NoError(t, err)
+	assert.Equal(t, "https://assets.com/blockchains/solana/tokenlist.json", list)
+
+	list, err = b.ExtendedTokenListURL(coin.SOLANA)
+	require.NoError(t, err)
+	assert.Equal(t, "https://assets.com/blockchains/solana/tokenlist-extended.json", list)
+
+	_, err = b.TokenListURL(123)
+	assert.ErrorIs(t, err, ErrUnknownCoin)
+
+	_, err = b.InfoURL(ID{})
+	assert.ErrorIs(t, err, ErrBadAssetID)
+}
@@ -0,0 +1,143 @@
+package coin
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	CAIP2NamespaceEIP155 = "eip155"
+	CAIP2NamespaceCosmos = "cosmos"
+	CAIP2NamespaceSolana = "solana"
+	CAIP2NamespaceTron   = "tron"
+)
+
+var ErrUnsupportedCAIP2 = errors.New("unsupported CAIP-2 chain")
+
+// derivedCoinIDBase is the offset of coin IDs derived from the SLIP-44 type of another
+// chain, e.g. 20000714 for Smart Chain or 10000118 for Osmosis
+const derivedCoinIDBase = 10000000
+
+// caip2Chains holds the CAIP-2 chain IDs of coins outside the eip155 namespace.
+// Bitcoin-like, Solana and Polkadot references are the truncated genesis block hash,
+// Cosmos SDK references are the chain ID of the network.
+var caip2Chains = map[uint]string{
+	BITCOIN:     "bip122:000000000019d6689c085ae165831e93",
+	LITECOIN:    "bip122:12a765e31ffd4059bada1e25190f6e98",
+	DOGE:        "bip122:1a91e3dace36e2be3bf030a65679fe82",
+	DASH:        "bip122:00000ffd590b1485b3caadc19b22e637",
+	BITCOINCASH: "bip122:000000000000000000651ef99cb9fcbe",
+
+	COSMOS:          "cosmos:cosmoshub-4",
+	KAVA:            "cosmos:kava_2222-10",
+	TERRA:           "cosmos:columbus-5",
+	BAND:            "cosmos:laozi-mainnet",
+	OSMOSIS:         "cosmos:osmosis-1",
+	NATIVEEVMOS:     "cosmos:evmos_9001-2",
+	CRYPTOORG:       "cosmos:crypto-org-chain-mainnet-1",
+	STRIDE:          "cosmos:stride-1",
+	NEUTRON:         "cosmos:neutron-1",
+	STARGAZE:        "cosmos:stargaze-1",
+	NATIVEINJECTIVE: "cosmos:injective-1",
+	AKASH:           "cosmos:akashnet-2",
+	AGORIC:          "cosmos:agoric-3",
+	AXELAR:          "cosmos:axelar-dojo-1",
+	JUNO:            "cosmos:juno-1",
+	SEI:             "cosmos:pacific-1",
+	ZETACHAIN:       "cosmos:zetachain_7000-1",
+	TIA:             "cosmos:celestia",
+	DYDX:            "cosmos:dydx-mainnet-1",
+	THORCHAIN:       "cosmos:thorchain-1",
+	BINANCE:         "cosmos:Binance-Chain-Tigris",
+
+	SOLANA:   "solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp",
+	POLKADOT: "polkadot:91b171bb158e2d3848fa23a9f1c25182",
+	KUSAMA:   "polkadot:b0a8d493285c2df73290dfb7e61f870f",
+	TRON:     "tron:0x2b6653dc",
+	RIPPLE:   "xrpl:0",
+	STELLAR:  "stellar:pubnet",
+	NEAR:     "near:mainnet",
+	TEZOS:    "tezos:NetXdQprcVkpaWU",
+	ALGORAND: "algorand:wGHE2Pwdvd7S12BL5FaOP20EGYesN73k",
+	CARDANO:  "cip34:1-764824073",
+}
+
+// networkCAIP2Chains holds the CAIP-2 chain IDs of non-EVM testnets and devnets by handle,
+// since networks share the ID of their mainnet coin.
+var networkCAIP2Chains = map[string]string{
+	"solana_devnet": "solana:EtWTRABZaYq6iMfeYKouRu166VU2xqa1",
+	"tron_nile":     "tron:0xcd8690dc",
+}
+
+// CAIP2 returns the CAIP-2 chain ID of the coin, e.g. "eip155:1" or "cosmos:osmosis-1".
+// EVM chains use the eip155 namespace with the coin's ChainID. Testnets have their own
+// chain ID, never the one of their mainnet.
+func (c Coin) CAIP2() (string, error) {
+	if c.Blockchain == BlockchainEthereum && c.ChainID != nil {
+		return CAIP2NamespaceEIP155 + ":" + strconv.FormatUint(uint64(*c.ChainID), 10), nil
+	}
+	if NetworkOf(c) != Mainnet {
+		if chainID, ok := networkCAIP2Chains[c.Handle]; ok {
+			return chainID, nil
+		}
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedCAIP2, c.Handle)
+	}
+	if chainID, ok := caip2Chains[c.ID]; ok {
+		return chainID, nil
+	}
+	return "", fmt.Errorf("%w: %s", ErrUnsupportedCAIP2, c.Handle)
+}
+
+// SLIP44 returns the SLIP-44 coin type of the native asset, e.g. 60 for the ETH of Optimism.
+// Coins with derived IDs only have one when coins.yml sets their slip44.
+func (c Coin) SLIP44() (uint, bool) {
+	if slip44, ok := slip44s[c.ID]; ok {
+		return slip44, true
+	}
+	if c.ID < derivedCoinIDBase {
+		return c.ID, true
+	}
+	return 0, false
+}
+
+// GetCoinByCAIP2 returns the coin of a CAIP-2 chain ID, testnets return the coin of their network.
+func GetCoinByCAIP2(chainID string) (Coin, error) {
+	n, err := GetNetworkByCAIP2(chainID)
+	if err != nil {
+		return Coin{}, err
+	}
+	return n.Coin, nil
+}
+
+// GetNetworkByCAIP2 returns the mainnet or testnet of a CAIP-2 chain ID, e.g. "eip155:1" or "eip155:11155111".
+func GetNetworkByCAIP2(chainID string) (Network, error) {
+	namespace, reference, ok := strings.Cut(chainID, ":")
+	if !ok || reference == "" {
+		return Network{}, fmt.Errorf("%w: %s", ErrUnsupportedCAIP2, chainID)
+	}
+
+	if namespace == CAIP2NamespaceEIP155 {
+		id, err := strconv.ParseUint(reference, 10, 64)
+		if err != nil {
+			return Network{}, fmt.Errorf("%w: %s", ErrUnsupportedCAIP2, chainID)
+		}
+		if n, ok := GetNetworkByChainID(uint(id)); ok && n.Blockchain == BlockchainEthereum && uint64(*n.ChainID) == id {
+			return n, nil
+		}
+		return Network{}, fmt.Errorf("%w: %s", ErrUnsupportedCAIP2, chainID)
+	}
+
+	for id, caip2 := range caip2Chains {
+		if caip2 == chainID {
+			return Network{Coin: Coins[id], Type: Mainnet}, nil
+		}
+	}
+	for handle, caip2 := range networkCAIP2Chains {
+		if caip2 == chainID {
+			return networks[handle], nil
+		}
+	}
+	return Network{}, fmt.Errorf("%w: %s", ErrUnsupportedCAIP2, chainID)
+}
@@ -0,0 +1,100 @@
+package coin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoin_CAIP2(t *testing.T) {
+	tests := []struct {
+		name    string
+		coin    Coin
+		want    string
+		wantErr error
+	}{
+		{"ethereum", Ethereum(), "eip155:1", nil},
+		{"smart chain", Smartchain(), "eip155:56", nil},
+		{"arbitrum", Arbitrum(), "eip155:42161", nil},
+		{"bitcoin", Bitcoin(), "bip122:000000000019d6689c085ae165831e93", nil},
+		{"cosmos", Cosmos(), "cosmos:cosmoshub-4", nil},
+		{"osmosis", Osmosis(), "cosmos:osmosis-1", nil},
+		{"solana", Solana(), "solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp", nil},
+		{"polkadot", Polkadot(), "polkadot:91b171bb158e2d3848fa23a9f1c25182", nil},
+		{"unsupported", Nimiq(), "", ErrUnsupportedCAIP2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.coin.CAIP2()
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetCoinByCAIP2(t *testing.T) {
+	tests := []struct {
+		name    string
+		chainID string
+		want    Coin
+		wantErr error
+	}{
+		{"ethereum", "eip155:1", Ethereum(), nil},
+		{"optimism", "eip155:10", Optimism(), nil},
+		{"osmosis", "cosmos:osmosis-1", Osmosis(), nil},
+		{"kusama", "polkadot:b0a8d493285c2df73290dfb7e61f870f", Kusama(), nil},
+		{"unknown evm chain", "eip155:999999999", Coin{}, ErrUnsupportedCAIP2},
+		{"invalid evm reference", "eip155:mainnet", Coin{}, ErrUnsupportedCAIP2},
+		{"unknown cosmos chain", "cosmos:unknown-1", Coin{}, ErrUnsupportedCAIP2},
+		{"no reference", "eip155", Coin{}, ErrUnsupportedCAIP2},
+		{"empty reference", "eip155:", Coin{}, ErrUnsupportedCAIP2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetCoinByCAIP2(tt.chainID)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCAIP2RoundTrip(t *testing.T) {
+	for _, c := range Coins {
+		chainID, err := c.CAIP2()
+		if err != nil {
+			continue
+		}
+		got, err := GetCoinByCAIP2(chainID)
+		assert.NoError(t, err, chainID)
+		assert.Equal(t, c.ID, got.ID, chainID)
+	}
+}
+
+func TestCoin_SLIP44(t *testing.T) {
+	tests := []struct {
+		name   string
+		coin   Coin
+		want   uint
+		wantOk bool
+	}{
+		{"ethereum", Ethereum(), 60, true},
+		{"bitcoin", Bitcoin(), 0, true},
+		{"optimism", Optimism(), 60, true},
+		{"arbitrum", Arbitrum(), 60, true},
+		{"base", Base(), 60, true},
+		{"smart chain", Smartchain(), 714, true},
+		{"merlin", Merlin(), 0, true},
+		{"fantom", Fantom(), 1007, true},
+		{"osmosis", Osmosis(), 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.coin.SLIP44()
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -1,29 +1,31 @@
 // Code generated by go generate; DO NOT EDIT.
-// This file was generated by robots at
-// 2026-06-19 11:55:57.822475 &#43;0900 KST m=&#43;0.001658542
-// using data from coins.yml
+// This file was generated by robots using data from coins.yml
 package coin
 
 import (
 	"fmt"
+	"regexp"
 )
 
 const (
-	coinPrefix  = "c"
-	tokenPrefix = "t"
+	coinPrefix       = "c"
+	tokenPrefix      = "t"
+	networkSeparator = "@"
 )
 
 // Coin is the native currency of a blockchain
 type Coin struct {
-	ID               uint
-	Handle           string
-	Symbol           string
-	Name             string
-	Decimals         uint
-	BlockTime        int
-	MinConfirmations int64
-	Blockchain       string // Name of the Blockchain which core is used for this network
-	ChainID          *uint  // EIP155; Source: https://chainlist.org
+	ID               uint   `json:"id" yaml:"id"`
+	Handle           string `json:"handle" yaml:"handle"`
+	Symbol           string `json:"symbol" yaml:"symbol"`
+	Name             string `json:"name" yaml:"name"`
+	Decimals         uint   `json:"decimals" yaml:"decimals"`
+	BlockTime        int    `json:"blockTime" yaml:"blockTime"`
+	MinConfirmations int64  `json:"minConfirmations" yaml:"minConfirmations"`
+	Blockchain       string `json:"blockchain" yaml:"blockchain"`               // Name of the Blockchain which core is used for this network
+	ChainID          *uint  `json:"chainId,omitempty" yaml:"chainId,omitempty"` // EIP155; Source: https://chainlist.org
+	EIP1191          bool   `json:"eip1191,omitempty" yaml:"eip1191,omitempty"` // Addresses use the chain ID aware EIP1191 checksum instead of EIP55
+	Deprecated       bool   `json:"deprecated" yaml:"deprecated"`               // Kept for historical data, not supported anymore
 }
 
 type AssetID string
@@ -32,8 +34,14 @@ func (c *Coin) String() string {
 	return fmt.Sprintf("[%s] %s (#%d)", c.Symbol, c.Name, c.ID)
 }
 
+// AssetID returns the asset ID of the native asset, testnets add their handle, e.g. "c60@sepolia",
+// so it never equals the asset ID of their mainnet.
 func (c Coin) AssetID() AssetID {
-	return AssetID(coinPrefix + fmt.Sprint(c.ID))
+	result := AssetID(coinPrefix + fmt.Sprint(c.ID))
+	if NetworkOf(c) != Mainnet {
+		result += AssetID(networkSeparator + c.Handle)
+	}
+	return result
 }
 
 func (c Coin) TokenAssetID(t string) AssetID {
@@ -49,6 +57,9 @@ func ptr[T any](v T) *T {
 	return &v
 }
 
+// handlePattern is the format of coin handles, checked by the generator and by Registry overlays
+var handlePattern = regexp.MustCompile("^[a-z][a-z0-9]*(_[a-z0-9]+)*$")
+
 const (
 	ETHEREUM     = 60
 	CLASSIC      = 61
@@ -179,6 +190,7 @@ const (
 	MONAD             = 10143
 	HYPEREVM          = 10000999
 	ROBINHOODCHAIN    = 10004663
+	ROOTSTOCK         = 137
 )
 
 var Coins = map[uint]Coin{
@@ -876,6 +888,7 @@ var Coins = map[uint]Coin{
 		MinConfirmations: 12,
 		Blockchain:       "Ethereum",
 		ChainID:          ptr(uint(128)),
+		Deprecated:       true,
 	},
 	FANTOM: {
 		ID:               10000250,
@@ -1516,1343 +1529,1482 @@ var Coins = map[uint]Coin{
 		Blockchain:       "Ethereum",
 		ChainID:          ptr(uint(4663)),
 	},
-}
-
-var Chains = map[string]Coin{
-	Ethereum().Handle: {
-		ID:               60,
-		Handle:           "ethereum",
-		Symbol:           "ETH",
-		Name:             "Ethereum",
-		Decimals:         18,
-		BlockTime:        10000,
-		MinConfirmations: 12,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(1)),
-	},
-	Classic().Handle: {
-		ID:               61,
-		Handle:           "classic",
-		Symbol:           "ETC",
-		Name:             "Ethereum Classic",
+	ROOTSTOCK: {
+		ID:               137,
+		Handle:           "rootstock",
+		Symbol:           "RBTC",
+		Name:             "Rootstock",
 		Decimals:         18,
 		BlockTime:        30000,
 		MinConfirmations: 12,
 		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(61)),
+		ChainID:          ptr(uint(30)),
+		EIP1191:          true,
 	},
-	Icon().Handle: {
-		ID:               74,
-		Handle:           "icon",
-		Symbol:           "ICX",
-		Name:             "ICON",
-		Decimals:         18,
-		BlockTime:        10000,
-		MinConfirmations: 0,
-		Blockchain:       "Icon",
+}
+
+var Chains = map[string]Coin{
+	"ethereum":          Coins[ETHEREUM],
+	"classic":           Coins[CLASSIC],
+	"icon":              Coins[ICON],
+	"cosmos":            Coins[COSMOS],
+	"ripple":            Coins[RIPPLE],
+	"stellar":           Coins[STELLAR],
+	"poa":               Coins[POA],
+	"tron":              Coins[TRON],
+	"fio":               Coins[FIO],
+	"nimiq":             Coins[NIMIQ],
+	"iotex":             Coins[IOTEX],
+	"iotexevm":          Coins[IOTEXEVM],
+	"zilliqa":           Coins[ZILLIQA],
+	"aion":              Coins[AION],
+	"aeternity":         Coins[AETERNITY],
+	"kava":              Coins[KAVA],
+	"theta":             Coins[THETA],
+	"binance":           Coins[BINANCE],
+	"vechain":           Coins[VECHAIN],
+	"callisto":          Coins[CALLISTO],
+	"tomochain":         Coins[TOMOCHAIN],
+	"thundertoken":      Coins[THUNDERTOKEN],
+	"ontology":          Coins[ONTOLOGY],
+	"tezos":             Coins[TEZOS],
+	"kin":               Coins[KIN],
+	"nebulas":           Coins[NEBULAS],
+	"gochain":           Coins[GOCHAIN],
+	"wanchain":          Coins[WANCHAIN],
+	"waves":             Coins[WAVES],
+	"bitcoin":           Coins[BITCOIN],
+	"litecoin":          Coins[LITECOIN],
+	"doge":              Coins[DOGE],
+	"dash":              Coins[DASH],
+	"viacoin":           Coins[VIACOIN],
+	"groestlcoin":       Coins[GROESTLCOIN],
+	"zcash":             Coins[ZCASH],
+	"firo":              Coins[FIRO],
+	"bitcoincash":       Coins[BITCOINCASH],
+	"ravencoin":         Coins[RAVENCOIN],
+	"qtum":              Coins[QTUM],
+	"zelcash":           Coins[ZELCASH],
+	"decred":            Coins[DECRED],
+	"algorand":          Coins[ALGORAND],
+	"nano":              Coins[NANO],
+	"digibyte":          Coins[DIGIBYTE],
+	"harmony":           Coins[HARMONY],
+	"kusama":            Coins[KUSAMA],
+	"polkadot":          Coins[POLKADOT],
+	"solana":            Coins[SOLANA],
+	"near":              Coins[NEAR],
+	"elrond":            Coins[ELROND],
+	"smartchain":        Coins[SMARTCHAIN],
+	"filecoin":          Coins[FILECOIN],
+	"oasis":             Coins[OASIS],
+	"monacoin":          Coins[MONACOIN],
+	"bitcoingold":       Coins[BITCOINGOLD],
+	"eos":               Coins[EOS],
+	"terra":             Coins[TERRA],
+	"band":              Coins[BAND],
+	"neo":               Coins[NEO],
+	"cardano":           Coins[CARDANO],
+	"nuls":              Coins[NULS],
+	"polygon":           Coins[POLYGON],
+	"thorchain":         Coins[THORCHAIN],
+	"optimism":          Coins[OPTIMISM],
+	"xdai":              Coins[XDAI],
+	"avalanchec":        Coins[AVALANCHEC],
+	"heco":              Coins[HECO],
+	"fantom":            Coins[FANTOM],
+	"arbitrum":          Coins[ARBITRUM],
+	"celo":              Coins[CELO],
+	"ronin":             Coins[RONIN],
+	"osmosis":           Coins[OSMOSIS],
+	"cronos":            Coins[CRONOS],
+	"kcc":               Coins[KCC],
+	"aurora":            Coins[AURORA],
+	"kavaevm":           Coins[KAVAEVM],
+	"meter":             Coins[METER],
+	"evmos":             Coins[EVMOS],
+	"nativeevmos":       Coins[NATIVEEVMOS],
+	"okc":               Coins[OKC],
+	"cryptoorg":         Coins[CRYPTOORG],
+	"aptos":             Coins[APTOS],
+	"megaeth":           Coins[MEGAETH],
+	"moonbeam":          Coins[MOONBEAM],
+	"klaytn":            Coins[KLAYTN],
+	"metis":             Coins[METIS],
+	"moonriver":         Coins[MOONRIVER],
+	"boba":              Coins[BOBA],
+	"ton":               Coins[TON],
+	"polygonzkevm":      Coins[POLYGONZKEVM],
+	"zksync":            Coins[ZKSYNC],
+	"sui":               Coins[SUI],
+	"stride":            Coins[STRIDE],
+	"neutron":           Coins[NEUTRON],
+	"stargaze":          Coins[STARGAZE],
+	"nativeinjective":   Coins[NATIVEINJECTIVE],
+	"cfxevm":            Coins[CFXEVM],
+	"acala":             Coins[ACALA],
+	"acalaevm":          Coins[ACALAEVM],
+	"base":              Coins[BASE],
+	"akash":             Coins[AKASH],
+	"agoric":            Coins[AGORIC],
+	"axelar":            Coins[AXELAR],
+	"juno":              Coins[JUNO],
+	"sei":               Coins[SEI],
+	"seievm":            Coins[SEIEVM],
+	"neon":              Coins[NEON],
+	"opbnb":             Coins[OPBNB],
+	"linea":             Coins[LINEA],
+	"gbnb":              Coins[GBNB],
+	"mantle":            Coins[MANTLE],
+	"manta":             Coins[MANTA],
+	"zetachain":         Coins[ZETACHAIN],
+	"zetaevm":           Coins[ZETAEVM],
+	"merlin":            Coins[MERLIN],
+	"blast":             Coins[BLAST],
+	"scroll":            Coins[SCROLL],
+	"internet_computer": Coins[INTERNET_COMPUTER],
+	"bouncebit":         Coins[BOUNCEBIT],
+	"zklinknova":        Coins[ZKLINKNOVA],
+	"sonic":             Coins[SONIC],
+	"tia":               Coins[TIA],
+	"dydx":              Coins[DYDX],
+	"plasma":            Coins[PLASMA],
+	"monad":             Coins[MONAD],
+	"hyperevm":          Coins[HYPEREVM],
+	"robinhoodchain":    Coins[ROBINHOODCHAIN],
+	"rootstock":         Coins[ROOTSTOCK],
+}
+
+var explorers = map[uint]Explorer{
+	ETHEREUM: {
+		Token:   "https://etherscan.io/token/{token}",
+		Address: "https://etherscan.io/address/{address}",
+		Tx:      "https://etherscan.io/tx/{tx}",
+		Block:   "https://etherscan.io/block/{block}",
 	},
-	Cosmos().Handle: {
-		ID:               118,
-		Handle:           "cosmos",
-		Symbol:           "ATOM",
-		Name:             "Cosmos",
-		Decimals:         6,
-		BlockTime:        5000,
-		MinConfirmations: 7,
-		Blockchain:       "Cosmos",
+	CLASSIC: {
+		Token:   "https://blockscout.com/etc/mainnet/tokens/{token}",
+		Address: "https://blockscout.com/etc/mainnet/address/{address}",
+		Tx:      "https://blockscout.com/etc/mainnet/tx/{tx}",
+		Block:   "https://blockscout.com/etc/mainnet/blocks/{block}",
 	},
-	Ripple().Handle: {
-		ID:               144,
-		Handle:           "ripple",
-		Symbol:           "XRP",
-		Name:             "Ripple",
-		Decimals:         6,
-		BlockTime:        5000,
-		MinConfirmations: 0,
-		Blockchain:       "Ripple",
+	ICON: {
+		Tx:    "https://tracker.icon.community/transaction/{tx}",
+		Block: "https://tracker.icon.community/block/{block}",
 	},
-	Stellar().Handle: {
-		ID:               148,
-		Handle:           "stellar",
-		Symbol:           "XLM",
-		Name:             "Stellar",
-		Decimals:         7,
-		BlockTime:        5000,
-		MinConfirmations: 0,
-		Blockchain:       "Stellar",
+	COSMOS: {
+		Tx:    "https://www.mintscan.io/cosmos/tx/{tx}",
+		Block: "https://www.mintscan.io/cosmos/block/{block}",
 	},
-	Poa().Handle: {
-		ID:               178,
-		Handle:           "poa",
-		Symbol:           "POA",
-		Name:             "Poa",
-		Decimals:         18,
-		BlockTime:        5000,
-		MinConfirmations: 12,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(99)),
+	RIPPLE: {
+		Token:   "https://xrpscan.com/account/{token}",
+		Address: "https://xrpscan.com/account/{address}",
+		Tx:      "https://xrpscan.com/tx/{tx}",
+		Block:   "https://xrpscan.com/ledger/{block}",
 	},
-	Tron().Handle: {
-		ID:               195,
-		Handle:           "tron",
-		Symbol:           "TRX",
-		Name:             "Tron",
-		Decimals:         6,
-		BlockTime:        10000,
-		MinConfirmations: 0,
-		Blockchain:       "Tron",
+	STELLAR: {
+		Token:   "https://stellar.expert/explorer/public/asset/{token}",
+		Address: "https://stellar.expert/explorer/public/account/{address}",
+		Tx:      "https://stellar.expert/explorer/public/tx/{tx}",
+		Block:   "https://stellar.expert/explorer/public/ledger/{block}",
 	},
-	Fio().Handle: {
-		ID:               235,
-		Handle:           "fio",
-		Symbol:           "FIO",
-		Name:             "FIO",
-		Decimals:         9,
-		BlockTime:        5000,
-		MinConfirmations: 0,
-		Blockchain:       "FIO",
+	POA: {
+		Token: "https://blockscout.com/poa/core/tokens/{token}",
+		Tx:    "https://blockscout.com/poa/core/tx/{tx}",
+		Block: "https://blockscout.com/poa/core/blocks/{block}",
 	},
-	Nimiq().Handle: {
-		ID:               242,
-		Handle:           "nimiq",
-		Symbol:           "NIM",
-		Name:             "Nimiq",
-		Decimals:         5,
-		BlockTime:        60000,
-		MinConfirmations: 0,
-		Blockchain:       "Nimiq",
+	TRON: {
+		Token:   "https://tronscan.io/#/token20/{token}",
+		Address: "https://tronscan.io/#/address/{address}",
+		Tx:      "https://tronscan.io/#/transaction/{tx}",
+		Block:   "https://tronscan.io/#/block/{block}",
+		TokenVariants: []ExplorerVariant{
+			{Numeric: true, URL: "https://tronscan.io/#/token/{token}"},
+		},
 	},
-	Iotex().Handle: {
-		ID:               304,
-		Handle:           "iotex",
-		Symbol:           "IOTX",
-		Name:             "IoTeX",
-		Decimals:         18,
-		BlockTime:        10000,
-		MinConfirmations: 0,
-		Blockchain:       "IoTeX",
+	FIO: {
+		Tx:    "https://fio.bloks.io/transaction/{tx}",
+		Block: "https://fio.bloks.io/block/{block}",
 	},
-	Iotexevm().Handle: {
-		ID:               10004689,
-		Handle:           "iotexevm",
-		Symbol:           "IOTX",
-		Name:             "IoTeX Network Mainnet",
-		Decimals:         18,
-		BlockTime:        10000,
-		MinConfirmations: 12,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(4689)),
+	NIMIQ: {
+		Tx:    "https://nimiq.watch/#{tx}",
+		Block: "https://nimiq.watch/#{block}",
 	},
-	Zilliqa().Handle: {
-		ID:               313,
-		Handle:           "zilliqa",
-		Symbol:           "ZIL",
-		Name:             "Zilliqa",
-		Decimals:         12,
-		BlockTime:        30000,
-		MinConfirmations: 1,
-		Blockchain:       "Zilliqa",
+	IOTEX: {
+		Tx:    "https://iotexscan.io/tx/{tx}",
+		Block: "https://iotexscan.io/block/{block}",
 	},
-	Aion().Handle: {
-		ID:               425,
-		Handle:           "aion",
-		Symbol:           "AION",
-		Name:             "Aion",
-		Decimals:         18,
-		BlockTime:        10000,
-		MinConfirmations: 0,
-		Blockchain:       "Aion",
+	IOTEXEVM: {
+		Token:   "https://iotexscan.io/address/{token}#transactions",
+		Address: "https://iotexscan.io/address/{address}#transactions",
+		Tx:      "https://iotexscan.io/tx/{tx}",
+		Block:   "https://iotexscan.io/block/{block}",
 	},
-	Aeternity().Handle: {
-		ID:               457,
-		Handle:           "aeternity",
-		Symbol:           "AE",
-		Name:             "Aeternity",
-		Decimals:         18,
-		BlockTime:        6000,
-		MinConfirmations: 0,
-		Blockchain:       "Aeternity",
+	ZILLIQA: {
+		Tx:    "https://viewblock.io/zilliqa/tx/{tx}",
+		Block: "https://viewblock.io/zilliqa/block/{block}",
 	},
-	Kava().Handle: {
-		ID:               459,
-		Handle:           "kava",
-		Symbol:           "KAVA",
-		Name:             "Kava",
-		Decimals:         6,
-		BlockTime:        5000,
-		MinConfirmations: 7,
-		Blockchain:       "Cosmos",
+	AION: {
+		Tx:    "https://mainnet.theoan.com/#/transaction/{tx}",
+		Block: "https://mainnet.theoan.com/#/block/{block}",
 	},
-	Theta().Handle: {
-		ID:               500,
-		Handle:           "theta",
-		Symbol:           "THETA",
-		Name:             "Theta",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 0,
-		Blockchain:       "Theta",
+	AETERNITY: {
+		Tx:    "https://aescan.io/transactions/{tx}",
+		Block: "https://aescan.io/keyblocks/{block}",
 	},
-	Binance().Handle: {
-		ID:               714,
-		Handle:           "binance",
-		Symbol:           "BNB",
-		Name:             "BNB",
-		Decimals:         8,
-		BlockTime:        1000,
-		MinConfirmations: 2,
-		Blockchain:       "Binance",
+	KAVA: {
+		Token:   "https://www.mintscan.io/kava",
+		Address: "https://www.mintscan.io/kava",
+		Tx:      "https://www.mintscan.io/kava/tx/{tx}",
+		Block:   "https://www.mintscan.io/kava/block/{block}",
 	},
-	Vechain().Handle: {
-		ID:               818,
-		Handle:           "vechain",
-		Symbol:           "VET",
-		Name:             "VeChain Token",
-		Decimals:         18,
-		BlockTime:        20000,
-		MinConfirmations: 0,
-		Blockchain:       "Vechain",
+	THETA: {
+		Token:   "https://explorer.thetatoken.org/",
+		Address: "https://explorer.thetatoken.org/",
+		Tx:      "https://explorer.thetatoken.org/txs/{tx}",
+		Block:   "https://explorer.thetatoken.org/blocks/{block}",
 	},
-	Callisto().Handle: {
-		ID:               820,
-		Handle:           "callisto",
-		Symbol:           "CLO",
-		Name:             "Callisto",
-		Decimals:         18,
-		BlockTime:        10000,
-		MinConfirmations: 12,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(820)),
+	BINANCE: {
+		Token:   "https://explorer.binance.org/asset/{token}",
+		Address: "https://explorer.binance.org/address/{address}",
+		Tx:      "https://explorer.binance.org/tx/{tx}",
+		Block:   "https://explorer.binance.org/block/{block}",
 	},
-	Tomochain().Handle: {
-		ID:               889,
-		Handle:           "tomochain",
-		Symbol:           "TOMO",
-		Name:             "TOMO",
-		Decimals:         18,
-		BlockTime:        4000,
-		MinConfirmations: 12,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(88)),
+	VECHAIN: {
+		Token:   "https://explore.vechain.org/accounts/{token}",
+		Address: "https://explore.vechain.org/accounts/{address}",
+		Tx:      "https://explore.vechain.org/transactions/{tx}",
+		Block:   "https://explore.vechain.org/blocks/{block}",
 	},
-	Thundertoken().Handle: {
-		ID:               1001,
-		Handle:           "thundertoken",
-		Symbol:           "TT",
-		Name:             "ThunderCore",
-		Decimals:         18,
-		BlockTime:        10000,
-		MinConfirmations: 36,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(108)),
+	CALLISTO: {
+		Tx:    "https://explorer.callisto.network/tx/{tx}",
+		Block: "https://explorer.callisto.network/block/{block}",
 	},
-	Ontology().Handle: {
-		ID:               1024,
-		Handle:           "ontology",
-		Symbol:           "ONT",
-		Name:             "Ontology",
-		Decimals:         0,
-		BlockTime:        10000,
-		MinConfirmations: 0,
-		Blockchain:       "Ontology",
+	TOMOCHAIN: {
+		Token:   "https://tomoscan.io/token/{token}",
+		Address: "https://tomoscan.io/address/{address}",
+		Tx:      "https://tomoscan.io/tx/{tx}",
+		Block:   "https://tomoscan.io/block/{block}",
 	},
-	Tezos().Handle: {
-		ID:               1729,
-		Handle:           "tezos",
-		Symbol:           "XTZ",
-		Name:             "Tezos",
-		Decimals:         6,
-		BlockTime:        20000,
-		MinConfirmations: 0,
-		Blockchain:       "Tezos",
+	THUNDERTOKEN: {
+		Token:   "https://viewblock.io/thundercore/address/{token}",
+		Address: "https://explorer-mainnet.thundercore.com/address/{address}",
+		Tx:      "https://explorer-mainnet.thundercore.com/tx/{tx}",
+		Block:   "https://explorer-mainnet.thundercore.com/block/{block}",
 	},
-	Kin().Handle: {
-		ID:               2017,
-		Handle:           "kin",
-		Symbol:           "KIN",
-		Name:             "Kin",
-		Decimals:         5,
-		BlockTime:        5000,
-		MinConfirmations: 0,
-		Blockchain:       "Stellar",
+	ONTOLOGY: {
+		Token:   "https://explorer.ont.io",
+		Address: "https://explorer.ont.io",
+		Tx:      "https://explorer.ont.io/transaction/{tx}",
+		Block:   "https://explorer.ont.io/block/{block}",
 	},
-	Nebulas().Handle: {
-		ID:               2718,
-		Handle:           "nebulas",
-		Symbol:           "NAS",
-		Name:             "Nebulas",
-		Decimals:         18,
-		BlockTime:        30000,
-		MinConfirmations: 0,
-		Blockchain:       "Nebulas",
+	TEZOS: {
+		Token:   "https://tzstats.com/{token}",
+		Address: "https://tzstats.com/{address}",
+		Tx:      "https://tzstats.com/{tx}",
+		Block:   "https://tzstats.com/{block}",
 	},
-	Gochain().Handle: {
-		ID:               6060,
-		Handle:           "gochain",
-		Symbol:           "GO",
-		Name:             "GoChain GO",
-		Decimals:         18,
-		BlockTime:        20000,
-		MinConfirmations: 12,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(60)),
+	KIN: {
+		Tx:    "https://www.kinexplorer.com/tx/{tx}",
+		Block: "https://www.kinexplorer.com/ledger/{block}",
 	},
-	Wanchain().Handle: {
-		ID:               5718350,
-		Handle:           "wanchain",
-		Symbol:           "WAN",
-		Name:             "Wanchain",
-		Decimals:         18,
-		BlockTime:        30000,
-		MinConfirmations: 12,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(888)),
+	NEBULAS: {
+		Tx:    "https://explorer.nebulas.io/#/tx/{tx}",
+		Block: "https://explorer.nebulas.io/#/block/{block}",
 	},
-	Waves().Handle: {
-		ID:               5741564,
-		Handle:           "waves",
-		Symbol:           "WAVES",
-		Name:             "WAVES",
-		Decimals:         8,
-		BlockTime:        30000,
-		MinConfirmations: 1,
-		Blockchain:       "Waves",
+	GOCHAIN: {
+		Token:   "https://explorer.gochain.io/addr/{token}",
+		Address: "https://explorer.gochain.io/addr/{address}",
+		Tx:      "https://explorer.gochain.io/tx/{tx}",
+		Block:   "https://explorer.gochain.io/block/{block}",
 	},
-	Bitcoin().Handle: {
-		ID:               0,
-		Handle:           "bitcoin",
-		Symbol:           "BTC",
-		Name:             "Bitcoin",
-		Decimals:         8,
-		BlockTime:        600000,
-		MinConfirmations: 0,
-		Blockchain:       "Bitcoin",
+	WANCHAIN: {
+		Token:   "https://www.wanscan.org/token/{token}",
+		Address: "https://www.wanscan.org/address/{address}",
+		Tx:      "https://www.wanscan.org/tx/{tx}",
+		Block:   "https://www.wanscan.org/block/{block}",
 	},
-	Litecoin().Handle: {
-		ID:               2,
-		Handle:           "litecoin",
-		Symbol:           "LTC",
-		Name:             "Litecoin",
-		Decimals:         8,
-		BlockTime:        150000,
-		MinConfirmations: 0,
-		Blockchain:       "Bitcoin",
+	WAVES: {
+		Token:   "https://wavesexplorer.com/assets/{token}",
+		Address: "https://wavesexplorer.com/addresses/{address}",
+		Tx:      "https://wavesexplorer.com/transactions/{tx}",
+		Block:   "https://wavesexplorer.com/blocks/{block}",
 	},
-	Doge().Handle: {
-		ID:               3,
-		Handle:           "doge",
-		Symbol:           "DOGE",
-		Name:             "Dogecoin",
-		Decimals:         8,
-		BlockTime:        60000,
-		MinConfirmations: 0,
-		Blockchain:       "Bitcoin",
+	BITCOIN: {
+		Token: "https://unisat.io/brc20/{token}",
+		Tx:    "https://mempool.space/tx/{tx}",
+		Block: "https://mempool.space/block/{block}",
 	},
-	Dash().Handle: {
-		ID:               5,
-		Handle:           "dash",
-		Symbol:           "DASH",
-		Name:             "Dash",
-		Decimals:         8,
-		BlockTime:        180000,
-		MinConfirmations: 0,
-		Blockchain:       "Bitcoin",
+	LITECOIN: {
+		Tx:    "https://litecoinspace.org/tx/{tx}",
+		Block: "https://litecoinspace.org/block/{block}",
 	},
-	Viacoin().Handle: {
-		ID:               14,
-		Handle:           "viacoin",
-		Symbol:           "VIA",
-		Name:             "Viacoin",
-		Decimals:         8,
-		BlockTime:        15000,
-		MinConfirmations: 0,
-		Blockchain:       "Bitcoin",
+	DOGE: {
+		Tx:    "https://blockchair.com/dogecoin/transaction/{tx}",
+		Block: "https://blockchair.com/dogecoin/block/{block}",
 	},
-	Groestlcoin().Handle: {
-		ID:               17,
-		Handle:           "groestlcoin",
-		Symbol:           "GRS",
-		Name:             "Groestlcoin",
-		Decimals:         8,
-		BlockTime:        60000,
-		MinConfirmations: 0,
-		Blockchain:       "Groestlcoin",
+	DASH: {
+		Tx:    "https://blockchair.com/dash/transaction/{tx}",
+		Block: "https://blockchair.com/dash/block/{block}",
 	},
-	Zcash().Handle: {
-		ID:               133,
-		Handle:           "zcash",
-		Symbol:           "ZEC",
-		Name:             "Zcash",
-		Decimals:         8,
-		BlockTime:        150000,
-		MinConfirmations: 0,
-		Blockchain:       "Zcash",
+	VIACOIN: {
+		Tx:    "https://explorer.viacoin.org/tx/{tx}",
+		Block: "https://explorer.viacoin.org/block/{block}",
 	},
-	Firo().Handle: {
-		ID:               136,
-		Handle:           "firo",
-		Symbol:           "FIRO",
-		Name:             "Firo",
-		Decimals:         8,
-		BlockTime:        300000,
-		MinConfirmations: 0,
-		Blockchain:       "Bitcoin",
+	GROESTLCOIN: {
+		Tx:    "https://chainz.cryptoid.info/grs/tx.dws?{tx}.htm",
+		Block: "https://chainz.cryptoid.info/grs/block.dws?{block}.htm",
 	},
-	Bitcoincash().Handle: {
-		ID:               145,
-		Handle:           "bitcoincash",
-		Symbol:           "BCH",
-		Name:             "Bitcoin Cash",
-		Decimals:         8,
-		BlockTime:        600000,
-		MinConfirmations: 0,
-		Blockchain:       "Bitcoin",
+	ZCASH: {
+		Tx:    "https://blockchair.com/zcash/transaction/{tx}",
+		Block: "https://blockchair.com/zcash/block/{block}",
 	},
-	Ravencoin().Handle: {
-		ID:               175,
-		Handle:           "ravencoin",
-		Symbol:           "RVN",
-		Name:             "Raven",
-		Decimals:         8,
-		BlockTime:        60000,
-		MinConfirmations: 0,
-		Blockchain:       "Bitcoin",
+	FIRO: {
+		Tx:    "https://explorer.firo.org/tx/{tx}",
+		Block: "https://explorer.firo.org/block-height/{block}",
 	},
-	Qtum().Handle: {
-		ID:               2301,
-		Handle:           "qtum",
-		Symbol:           "QTUM",
-		Name:             "Qtum",
-		Decimals:         8,
-		BlockTime:        60000,
-		MinConfirmations: 0,
-		Blockchain:       "Bitcoin",
+	BITCOINCASH: {
+		Tx:    "https://blockchair.com/bitcoin-cash/transaction/{tx}",
+		Block: "https://blockchair.com/bitcoin-cash/block/{block}",
 	},
-	Zelcash().Handle: {
-		ID:               19167,
-		Handle:           "zelcash",
-		Symbol:           "ZEL",
-		Name:             "Zelcash",
-		Decimals:         8,
-		BlockTime:        120000,
-		MinConfirmations: 0,
-		Blockchain:       "Zcash",
+	RAVENCOIN: {
+		Tx:    "https://ravencoin.network/tx/{tx}",
+		Block: "https://ravencoin.network/block-index/{block}",
 	},
-	Decred().Handle: {
-		ID:               42,
-		Handle:           "decred",
-		Symbol:           "DCR",
-		Name:             "Decred",
-		Decimals:         8,
-		BlockTime:        300000,
-		MinConfirmations: 0,
-		Blockchain:       "Decred",
+	QTUM: {
+		Tx:    "https://qtum.info/tx/{tx}",
+		Block: "https://qtum.info/block/{block}",
 	},
-	Algorand().Handle: {
-		ID:               283,
-		Handle:           "algorand",
-		Symbol:           "ALGO",
-		Name:             "Algorand",
-		Decimals:         6,
-		BlockTime:        20000,
-		MinConfirmations: 0,
-		Blockchain:       "Algorand",
+	ZELCASH: {
+		Tx:    "https://explorer.runonflux.io/tx/{tx}",
+		Block: "https://explorer.runonflux.io/block-index/{block}",
 	},
-	Nano().Handle: {
-		ID:               165,
-		Handle:           "nano",
-		Symbol:           "XNO",
-		Name:             "Nano",
-		Decimals:         30,
-		BlockTime:        0,
-		MinConfirmations: 0,
-		Blockchain:       "Nano",
+	DECRED: {
+		Tx:    "https://dcrdata.decred.org/tx/{tx}",
+		Block: "https://dcrdata.decred.org/block/{block}",
 	},
-	Digibyte().Handle: {
-		ID:               20,
-		Handle:           "digibyte",
-		Symbol:           "DGB",
-		Name:             "DigiByte",
-		Decimals:         8,
-		BlockTime:        15000,
-		MinConfirmations: 0,
-		Blockchain:       "Bitcoin",
+	ALGORAND: {
+		Token: "https://algoexplorer.io/asset/{token}",
+		Tx:    "https://algoexplorer.io/tx/{tx}",
+		Block: "https://algoexplorer.io/block/{block}",
 	},
-	Harmony().Handle: {
-		ID:               1023,
-		Handle:           "harmony",
-		Symbol:           "ONE",
-		Name:             "Harmony",
-		Decimals:         18,
-		BlockTime:        5000,
-		MinConfirmations: 0,
-		Blockchain:       "Harmony",
+	NANO: {
+		Tx: "https://nanexplorer.com/nano/block/{tx}",
 	},
-	Kusama().Handle: {
-		ID:               434,
-		Handle:           "kusama",
-		Symbol:           "KSM",
-		Name:             "Kusama",
-		Decimals:         12,
-		BlockTime:        6000,
-		MinConfirmations: 0,
-		Blockchain:       "Kusama",
+	DIGIBYTE: {
+		Tx:    "https://digiexplorer.info/tx/{tx}",
+		Block: "https://digiexplorer.info/block/{block}",
 	},
-	Polkadot().Handle: {
-		ID:               354,
-		Handle:           "polkadot",
-		Symbol:           "DOT",
-		Name:             "Polkadot",
-		Decimals:         10,
-		BlockTime:        6000,
-		MinConfirmations: 0,
-		Blockchain:       "Polkadot",
+	HARMONY: {
+		Tx:    "https://explorer.harmony.one/tx/{tx}",
+		Block: "https://explorer.harmony.one/block/{block}",
 	},
-	Solana().Handle: {
-		ID:               501,
-		Handle:           "solana",
-		Symbol:           "SOL",
-		Name:             "Solana",
-		Decimals:         9,
-		BlockTime:        500,
-		MinConfirmations: 0,
-		Blockchain:       "Solana",
+	KUSAMA: {
+		Tx:    "https://kusama.subscan.io/extrinsic/{tx}",
+		Block: "https://kusama.subscan.io/block/{block}",
 	},
-	Near().Handle: {
-		ID:               397,
-		Handle:           "near",
-		Symbol:           "NEAR",
-		Name:             "NEAR",
-		Decimals:         24,
-		BlockTime:        2000,
-		MinConfirmations: 0,
-		Blockchain:       "NEAR",
+	POLKADOT: {
+		Tx:    "https://polkadot.subscan.io/extrinsic/{tx}",
+		Block: "https://polkadot.subscan.io/block/{block}",
 	},
-	Elrond().Handle: {
-		ID:               508,
-		Handle:           "elrond",
-		Symbol:           "eGLD",
-		Name:             "Elrond",
-		Decimals:         18,
-		BlockTime:        6000,
-		MinConfirmations: 0,
-		Blockchain:       "ElrondNetwork",
+	SOLANA: {
+		Token:   "https://solscan.io/token/{token}",
+		Address: "https://solscan.io/account/{address}",
+		Tx:      "https://solscan.io/tx/{tx}",
+		Block:   "https://solscan.io/block/{block}",
 	},
-	Smartchain().Handle: {
-		ID:               20000714,
-		Handle:           "smartchain",
-		Symbol:           "BNB",
-		Name:             "Smart Chain",
-		Decimals:         18,
-		BlockTime:        1000,
-		MinConfirmations: 7,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(56)),
+	NEAR: {
+		Tx:    "https://nearblocks.io/txns/{tx}",
+		Block: "https://nearblocks.io/blocks/{block}",
 	},
-	Filecoin().Handle: {
-		ID:               461,
-		Handle:           "filecoin",
-		Symbol:           "FIL",
-		Name:             "Filecoin",
-		Decimals:         18,
-		BlockTime:        3000,
-		MinConfirmations: 0,
-		Blockchain:       "Filecoin",
+	ELROND: {
+		Token:   "https://explorer.multiversx.com/collections/{token}",
+		Address: "https://explorer.multiversx.com/accounts/{address}",
+		Tx:      "https://explorer.multiversx.com/transactions/{tx}",
+		Block:   "https://explorer.multiversx.com/blocks/{block}",
+		TokenVariants: []ExplorerVariant{
+			{TokenType: "ESDT", URL: "https://explorer.multiversx.com/tokens/{token}"},
+		},
 	},
-	Oasis().Handle: {
-		ID:               474,
-		Handle:           "oasis",
-		Symbol:           "ROSE",
-		Name:             "Oasis",
-		Decimals:         9,
-		BlockTime:        6000,
-		MinConfirmations: 0,
-		Blockchain:       "OasisNetwork",
+	SMARTCHAIN: {
+		Token:   "https://bscscan.com/token/{token}",
+		Address: "https://bscscan.com/address/{address}",
+		Tx:      "https://bscscan.com/tx/{tx}",
+		Block:   "https://bscscan.com/block/{block}",
 	},
-	Monacoin().Handle: {
-		ID:               22,
-		Handle:           "monacoin",
-		Symbol:           "MONA",
-		Name:             "Monacoin",
-		Decimals:         8,
-		BlockTime:        90000,
-		MinConfirmations: 0,
-		Blockchain:       "Bitcoin",
+	FILECOIN: {
+		Tx:    "https://filfox.info/en/message/{tx}",
+		Block: "https://filfox.info/en/tipset/{block}",
 	},
-	Bitcoingold().Handle: {
-		ID:               156,
-		Handle:           "bitcoingold",
-		Symbol:           "BTG",
-		Name:             "Bitcoin Gold",
-		Decimals:         8,
-		BlockTime:        600000,
-		MinConfirmations: 0,
-		Blockchain:       "Bitcoin",
+	OASIS: {
+		Token: "https://explorer.oasis.updev.si/token/{token}",
+		Tx:    "https://explorer.oasis.io/mainnet/consensus/tx/{tx}",
+		Block: "https://explorer.oasis.io/mainnet/consensus/block/{block}",
 	},
-	Eos().Handle: {
-		ID:               194,
-		Handle:           "eos",
-		Symbol:           "EOS",
-		Name:             "EOS",
-		Decimals:         4,
-		BlockTime:        500,
-		MinConfirmations: 0,
-		Blockchain:       "EOS",
+	MONACOIN: {
+		Tx:    "https://blockbook.electrum-mona.org/tx/{tx}",
+		Block: "https://blockbook.electrum-mona.org/block/{block}",
 	},
-	Terra().Handle: {
-		ID:               330,
-		Handle:           "terra",
-		Symbol:           "LUNC",
-		Name:             "Terra Classic",
-		Decimals:         6,
-		BlockTime:        0,
-		MinConfirmations: 7,
-		Blockchain:       "Cosmos",
+	BITCOINGOLD: {
+		Tx:    "https://explorer.bitcoingold.org/insight/tx/{tx}",
+		Block: "https://explorer.bitcoingold.org/insight/block-index/{block}",
 	},
-	Band().Handle: {
-		ID:               494,
-		Handle:           "band",
-		Symbol:           "BAND",
-		Name:             "BandChain",
-		Decimals:         6,
-		BlockTime:        2000,
-		MinConfirmations: 0,
-		Blockchain:       "Cosmos",
+	EOS: {
+		Token:   "https://bloks.io/account/{token}",
+		Address: "https://bloks.io/account/{address}",
+		Tx:      "https://bloks.io/transaction/{tx}",
+		Block:   "https://bloks.io/block/{block}",
 	},
-	Neo().Handle: {
-		ID:               888,
-		Handle:           "neo",
-		Symbol:           "NEO",
-		Name:             "NEO",
-		Decimals:         8,
-		BlockTime:        0,
-		MinConfirmations: 0,
-		Blockchain:       "NEO",
+	TERRA: {
+		Token:   "https://finder.terra.money/mainnet/address/{token}",
+		Address: "https://finder.terra.money/mainnet/address/{address}",
+		Tx:      "https://finder.terra.money/mainnet/tx/{tx}",
+		Block:   "https://finder.terra.money/mainnet/blocks/{block}",
 	},
-	Cardano().Handle: {
-		ID:               1815,
-		Handle:           "cardano",
-		Symbol:           "ADA",
-		Name:             "Cardano",
-		Decimals:         6,
-		BlockTime:        0,
-		MinConfirmations: 0,
-		Blockchain:       "Cardano",
+	BAND: {
+		Tx:    "https://www.mintscan.io/band/tx/{tx}",
+		Block: "https://www.mintscan.io/band/block/{block}",
 	},
-	Nuls().Handle: {
-		ID:               8964,
-		Handle:           "nuls",
-		Symbol:           "NULS",
-		Name:             "NULS",
-		Decimals:         8,
-		BlockTime:        0,
-		MinConfirmations: 0,
-		Blockchain:       "NULS",
+	NEO: {
+		Token: "https://neo.tokenview.com/en/token/0x{token}",
+		Tx:    "https://neo.tokenview.com/en/tx/{tx}",
+		Block: "https://neo.tokenview.com/en/block/{block}",
 	},
-	Polygon().Handle: {
-		ID:               966,
-		Handle:           "polygon",
-		Symbol:           "POL",
-		Name:             "POL (ex-MATIC)",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 12,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(137)),
+	CARDANO: {
+		Token:   "https://cexplorer.io/asset/{token}",
+		Address: "https://cexplorer.io/address/{address}",
+		Tx:      "https://cexplorer.io/tx/{tx}",
+		Block:   "https://cexplorer.io/block/{block}",
 	},
-	Thorchain().Handle: {
-		ID:               931,
-		Handle:           "thorchain",
-		Symbol:           "RUNE",
-		Name:             "THORChain",
-		Decimals:         8,
-		BlockTime:        0,
-		MinConfirmations: 0,
-		Blockchain:       "Thorchain",
+	NULS: {
+		Token:   "https://nulscan.io/token/info?contractAddress={token}",
+		Address: "https://nulscan.io/token/info?address={address}",
+		Tx:      "https://nulscan.io/transaction/info?hash={tx}",
+		Block:   "https://nulscan.io/block/info?height={block}",
 	},
-	Optimism().Handle: {
-		ID:               10000070,
-		Handle:           "optimism",
-		Symbol:           "OETH",
-		Name:             "Optimism Ethereum",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 36,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(10)),
+	POLYGON: {
+		Token:   "https://polygonscan.com/token/{token}",
+		Address: "https://polygonscan.com/address/{address}",
+		Tx:      "https://polygonscan.com/tx/{tx}",
+		Block:   "https://polygonscan.com/block/{block}",
 	},
-	Xdai().Handle: {
-		ID:               10000100,
-		Handle:           "xdai",
-		Symbol:           "xDAI",
-		Name:             "xDai",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 12,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(100)),
+	THORCHAIN: {
+		Tx:    "https://viewblock.io/thorchain/tx/{tx}",
+		Block: "https://viewblock.io/thorchain/block/{block}",
 	},
-	Avalanchec().Handle: {
-		ID:               10009000,
-		Handle:           "avalanchec",
-		Symbol:           "AVAX",
-		Name:             "Avalanche C-Chain",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 36,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(43114)),
+	OPTIMISM: {
+		Token:   "https://optimistic.etherscan.io/token/{token}",
+		Address: "https://optimistic.etherscan.io/address/{address}",
+		Tx:      "https://optimistic.etherscan.io/tx/{tx}",
+		Block:   "https://optimistic.etherscan.io/block/{block}",
 	},
-	Heco().Handle: {
-		ID:               10000553,
-		Handle:           "heco",
-		Symbol:           "HT",
-		Name:             "Huobi ECO Chain",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 12,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(128)),
+	XDAI: {
+		Token:   "https://blockscout.com/xdai/mainnet/tokens/{token}",
+		Address: "https://blockscout.com/xdai/mainnet/address/{address}",
+		Tx:      "https://blockscout.com/xdai/mainnet/tx/{tx}",
+		Block:   "https://blockscout.com/xdai/mainnet/blocks/{block}",
 	},
-	Fantom().Handle: {
-		ID:               10000250,
-		Handle:           "fantom",
-		Symbol:           "FTM",
-		Name:             "Fantom",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 12,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(250)),
+	AVALANCHEC: {
+		Token:   "https://snowtrace.io/token/{token}",
+		Address: "https://snowtrace.io/address/{address}",
+		Tx:      "https://snowtrace.io/tx/{tx}",
+		Block:   "https://snowtrace.io/block/{block}",
 	},
-	Arbitrum().Handle: {
-		ID:               10042221,
-		Handle:           "arbitrum",
-		Symbol:           "ARETH",
-		Name:             "Arbitrum",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 36,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(42161)),
+	HECO: {
+		Token: "https://hecoinfo.com/token/{token}",
+		Tx:    "https://hecoinfo.com/tx/{tx}",
+		Block: "https://hecoinfo.com/block/{block}",
 	},
-	Celo().Handle: {
-		ID:               52752,
-		Handle:           "celo",
-		Symbol:           "CELO",
-		Name:             "Celo",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 12,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(42220)),
+	FANTOM: {
+		Token:   "https://ftmscan.com/token/{token}",
+		Address: "https://explorer.fantom.network/address/{address}",
+		Tx:      "https://ftmscan.com/tx/{tx}",
+		Block:   "https://ftmscan.com/block/{block}",
 	},
-	Ronin().Handle: {
-		ID:               10002020,
-		Handle:           "ronin",
-		Symbol:           "RON",
-		Name:             "Ronin",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 12,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(2020)),
+	ARBITRUM: {
+		Token:   "https://arbiscan.io/token/{token}",
+		Address: "https://arbiscan.io/address/{address}",
+		Tx:      "https://arbiscan.io/tx/{tx}",
+		Block:   "https://arbiscan.io/block/{block}",
 	},
-	Osmosis().Handle: {
-		ID:               10000118,
-		Handle:           "osmosis",
-		Symbol:           "OSMO",
-		Name:             "Osmosis",
-		Decimals:         6,
-		BlockTime:        0,
-		MinConfirmations: 7,
-		Blockchain:       "Cosmos",
+	CELO: {
+		Token:   "https://explorer.celo.org/mainnet/address/{token}",
+		Address: "https://explorer.celo.org/mainnet/address/{address}",
+		Tx:      "https://explorer.celo.org/mainnet/tx/{tx}",
+		Block:   "https://explorer.celo.org/mainnet/block/{block}",
 	},
-	Cronos().Handle: {
-		ID:               10000025,
-		Handle:           "cronos",
-		Symbol:           "CRO",
-		Name:             "Cronos",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 12,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(25)),
+	RONIN: {
+		Token:   "https://explorer.roninchain.com/token/{token}",
+		Address: "https://explorer.roninchain.com/address/{address}",
+		Tx:      "https://explorer.roninchain.com/tx/{tx}",
+		Block:   "https://explorer.roninchain.com/block/{block}",
 	},
-	Kcc().Handle: {
-		ID:               10000321,
-		Handle:           "kcc",
-		Symbol:           "KCS",
-		Name:             "KuCoin Community Chain",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 12,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(321)),
+	OSMOSIS: {
+		Tx:    "https://www.mintscan.io/osmosis/tx/{tx}",
+		Block: "https://www.mintscan.io/osmosis/block/{block}",
 	},
-	Aurora().Handle: {
-		ID:               1323161554,
-		Handle:           "aurora",
-		Symbol:           "AURORAETH",
-		Name:             "Aurora",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 36,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(1313161554)),
+	CRONOS: {
+		Token:   "https://cronos.org/explorer/address/{token}/token-transfers",
+		Address: "https://explorer.cronos.org/address/{address}",
+		Tx:      "https://explorer.cronos.org/tx/{tx}",
+		Block:   "https://explorer.cronos.org/block/{block}",
 	},
-	Kavaevm().Handle: {
-		ID:               10002222,
-		Handle:           "kavaevm",
-		Symbol:           "KAVA",
-		Name:             "KavaEvm",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 7,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(2222)),
+	KCC: {
+		Token:   "https://explorer.kcc.io/token/{token}",
+		Address: "https://explorer.kcc.io/address/{address}",
+		Tx:      "https://explorer.kcc.io/tx/{tx}",
+		Block:   "https://explorer.kcc.io/block/{block}",
 	},
-	Meter().Handle: {
-		ID:               18000,
-		Handle:           "meter",
-		Symbol:           "MTR",
-		Name:             "Meter",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 12,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(82)),
+	AURORA: {
+		Token:   "https://aurorascan.dev/address/{token}",
+		Address: "https://aurorascan.dev/address/{address}",
+		Tx:      "https://aurorascan.dev/tx/{tx}",
+		Block:   "https://aurorascan.dev/block/{block}",
 	},
-	Evmos().Handle: {
-		ID:               10009001,
-		Handle:           "evmos",
-		Symbol:           "EVMOS",
-		Name:             "Evmos",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 12,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(9001)),
+	KAVAEVM: {
+		Token:   "https://explorer.kava.io/token/{token}",
+		Address: "https://explorer.kava.io/address/{address}",
+		Tx:      "https://explorer.kava.io/tx/{tx}",
+		Block:   "https://explorer.kava.io/block/{block}",
 	},
-	Nativeevmos().Handle: {
-		ID:               20009001,
-		Handle:           "nativeevmos",
-		Symbol:           "EVMOS",
-		Name:             "NativeEvmos",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 7,
-		Blockchain:       "Cosmos",
+	METER: {
+		Token:   "https://scan.meter.io/address/{token}",
+		Address: "https://scan.meter.io/address/{address}",
+		Tx:      "https://scan.meter.io/tx/{tx}",
+		Block:   "https://scan.meter.io/block/{block}",
 	},
-	Okc().Handle: {
-		ID:               996,
-		Handle:           "okc",
-		Symbol:           "OKT",
-		Name:             "OKX Chain",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 7,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(66)),
+	EVMOS: {
+		Token: "https://evm.evmos.org/address/{token}",
+		Tx:    "https://evm.evmos.org/tx/{tx}",
+		Block: "https://evm.evmos.org/block/{block}",
 	},
-	Cryptoorg().Handle: {
-		ID:               394,
-		Handle:           "cryptoorg",
-		Symbol:           "CRO",
-		Name:             "CryptoOrg",
-		Decimals:         8,
-		BlockTime:        0,
-		MinConfirmations: 7,
-		Blockchain:       "Cosmos",
+	NATIVEEVMOS: {
+		Tx:    "https://www.mintscan.io/evmos/tx/{tx}",
+		Block: "https://www.mintscan.io/evmos/block/{block}",
 	},
-	Aptos().Handle: {
-		ID:               637,
-		Handle:           "aptos",
-		Symbol:           "APTOS",
-		Name:             "Aptos",
-		Decimals:         8,
-		BlockTime:        0,
-		MinConfirmations: 0,
-		Blockchain:       "Aptos",
+	OKC: {
+		Token: "https://www.oklink.com/en/okc/address/{token}",
+		Tx:    "https://www.oklink.com/en/okc/tx/{tx}",
+		Block: "https://www.oklink.com/en/okc/block/{block}",
 	},
-	Megaeth().Handle: {
-		ID:               4326,
-		Handle:           "megaeth",
-		Symbol:           "ETH",
-		Name:             "MegaETH",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 12,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(4326)),
+	CRYPTOORG: {
+		Token:   "https://crypto.org/explorer/account/{token}",
+		Address: "https://crypto.org/explorer/account/{address}",
+		Tx:      "https://crypto.org/explorer/tx/{tx}",
+		Block:   "https://crypto.org/explorer/block/{block}",
 	},
-	Moonbeam().Handle: {
-		ID:               10001284,
-		Handle:           "moonbeam",
-		Symbol:           "GLMR",
-		Name:             "Moonbeam",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 7,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(1284)),
+	APTOS: {
+		Token:   "https://explorer.aptoslabs.com/coin/{token}?network=mainnet",
+		Address: "https://explorer.aptoslabs.com/account/{address}?network=mainnet",
+		Tx:      "https://explorer.aptoslabs.com/txn/{tx}?network=mainnet",
+		Block:   "https://explorer.aptoslabs.com/block/{block}?network=mainnet",
+		TokenVariants: []ExplorerVariant{
+			{TokenType: "APTOSFA", URL: "https://explorer.aptoslabs.com/fungible_asset/{token}?network=mainnet"},
+		},
 	},
-	Klaytn().Handle: {
-		ID:               10008217,
-		Handle:           "klaytn",
-		Symbol:           "KLAY",
-		Name:             "Kaia",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 36,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(8217)),
+	MEGAETH: {
+		Token:   "https://mega.etherscan.com/token/{token}",
+		Address: "https://mega.etherscan.com/address/{address}",
+		Tx:      "https://mega.etherscan.com/tx/{tx}",
+		Block:   "https://mega.etherscan.com/block/{block}",
 	},
-	Metis().Handle: {
-		ID:               10001088,
-		Handle:           "metis",
-		Symbol:           "METIS",
-		Name:             "Metis",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 36,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(1088)),
+	MOONBEAM: {
+		Token:   "https://moonscan.io/token/{token}",
+		Address: "https://moonscan.io/address/{address}",
+		Tx:      "https://moonscan.io/tx/{tx}",
+		Block:   "https://moonscan.io/block/{block}",
 	},
-	Moonriver().Handle: {
-		ID:               10001285,
-		Handle:           "moonriver",
-		Symbol:           "MOVR",
-		Name:             "Moonriver",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 2,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(1285)),
+	KLAYTN: {
+		Token:   "https://kaiascan.io/token/{token}",
+		Address: "https://kaiascan.io/address/{address}",
+		Tx:      "https://kaiascan.io/tx/{tx}",
+		Block:   "https://kaiascan.io/block/{block}",
 	},
-	Boba().Handle: {
-		ID:               10000288,
-		Handle:           "boba",
-		Symbol:           "BOBAETH",
-		Name:             "Boba",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 1,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(288)),
+	METIS: {
+		Token:   "https://andromeda-explorer.metis.io/token/{token}",
+		Address: "https://andromeda-explorer.metis.io/address/{address}",
+		Tx:      "https://andromeda-explorer.metis.io/tx/{tx}",
+		Block:   "https://andromeda-explorer.metis.io/block/{block}",
 	},
-	Ton().Handle: {
-		ID:               607,
-		Handle:           "ton",
-		Symbol:           "TON",
-		Name:             "TON",
-		Decimals:         9,
-		BlockTime:        0,
-		MinConfirmations: 0,
-		Blockchain:       "The Open Network",
+	MOONRIVER: {
+		Token:   "https://moonriver.moonscan.io/token/{token}",
+		Address: "https://moonriver.moonscan.io/address/{address}",
+		Tx:      "https://moonriver.moonscan.io/tx/{tx}",
+		Block:   "https://moonriver.moonscan.io/block/{block}",
 	},
-	Polygonzkevm().Handle: {
-		ID:               10001101,
-		Handle:           "polygonzkevm",
-		Symbol:           "ZKEVM",
-		Name:             "Polygon zkEVM",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 36,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(1101)),
+	BOBA: {
+		Token:   "https://bobascan.com/token/{token}",
+		Address: "https://bobascan.com/address/{address}",
+		Tx:      "https://bobascan.com/tx/{tx}",
+		Block:   "https://bobascan.com/block/{block}",
 	},
-	Zksync().Handle: {
-		ID:               10000324,
-		Handle:           "zksync",
-		Symbol:           "ZKSYNC",
-		Name:             "Zksync",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 36,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(324)),
+	TON: {
+		Token:   "https://tonscan.org/address/{token}",
+		Address: "https://tonscan.org/address/{address}",
+		Tx:      "https://tonscan.org/tx/{tx}",
+		Block:   "https://tonscan.org/block/-1:8000000000000000:{block}",
 	},
-	Sui().Handle: {
-		ID:               784,
-		Handle:           "sui",
-		Symbol:           "SUI",
-		Name:             "Sui",
-		Decimals:         9,
-		BlockTime:        0,
-		MinConfirmations: 1,
-		Blockchain:       "Sui",
+	POLYGONZKEVM: {
+		Token:   "https://explorer.public.zkevm-test.net/address/{token}",
+		Address: "https://explorer.public.zkevm-test.net/address/{address}",
+		Tx:      "https://zkevm.polygonscan.com/tx/{tx}",
+		Block:   "https://zkevm.polygonscan.com/block/{block}",
 	},
-	Stride().Handle: {
-		ID:               40000118,
-		Handle:           "stride",
-		Symbol:           "STRD",
-		Name:             "Stride",
-		Decimals:         6,
-		BlockTime:        0,
-		MinConfirmations: 7,
-		Blockchain:       "Cosmos",
+	ZKSYNC: {
+		Token:   "https://explorer.zksync.io/address/{token}",
+		Address: "https://explorer.zksync.io/address/{address}",
+		Tx:      "https://explorer.zksync.io/tx/{tx}",
+		Block:   "https://explorer.zksync.io/batch/{block}",
 	},
-	Neutron().Handle: {
-		ID:               90000118,
-		Handle:           "neutron",
-		Symbol:           "NTRN",
-		Name:             "Neutron",
-		Decimals:         6,
-		BlockTime:        0,
-		MinConfirmations: 10,
-		Blockchain:       "Cosmos",
+	SUI: {
+		Token:   "https://explorer.sui.io/address/{token}",
+		Address: "https://explorer.sui.io/address/{address}",
+		Tx:      "https://explorer.sui.io/txblock/{tx}",
+		Block:   "https://explorer.sui.io/checkpoint/{block}",
 	},
-	Stargaze().Handle: {
-		ID:               20000118,
-		Handle:           "stargaze",
-		Symbol:           "STARS",
-		Name:             "Stargaze",
-		Decimals:         6,
-		BlockTime:        0,
-		MinConfirmations: 7,
-		Blockchain:       "Cosmos",
+	STRIDE: {
+		Token:   "https://www.mintscan.io/stride/account/{token}",
+		Address: "https://www.mintscan.io/stride/account/{address}",
+		Tx:      "https://www.mintscan.io/stride/tx/{tx}",
+		Block:   "https://www.mintscan.io/stride/block/{block}",
 	},
-	Nativeinjective().Handle: {
-		ID:               10000060,
-		Handle:           "nativeinjective",
-		Symbol:           "INJ",
-		Name:             "NativeInjective",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 30,
-		Blockchain:       "Cosmos",
+	NEUTRON: {
+		Token:   "https://www.mintscan.io/neutron/account/{token}",
+		Address: "https://www.mintscan.io/neutron/account/{address}",
+		Tx:      "https://www.mintscan.io/neutron/tx/{tx}",
+		Block:   "https://www.mintscan.io/neutron/block/{block}",
 	},
-	Cfxevm().Handle: {
-		ID:               1030,
-		Handle:           "cfxevm",
-		Symbol:           "CFX",
-		Name:             "Conflux eSpace",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 36,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(1030)),
+	STARGAZE: {
+		Tx:    "https://www.mintscan.io/stargaze/tx/{tx}",
+		Block: "https://www.mintscan.io/stargaze/block/{block}",
 	},
-	Acala().Handle: {
-		ID:               787,
-		Handle:           "acala",
-		Symbol:           "ACA",
-		Name:             "Acala",
-		Decimals:         12,
-		BlockTime:        0,
-		MinConfirmations: 0,
-		Blockchain:       "Polkadot",
+	NATIVEINJECTIVE: {
+		Tx:    "https://www.mintscan.io/injective/tx/{tx}",
+		Block: "https://www.mintscan.io/injective/block/{block}",
 	},
-	Acalaevm().Handle: {
-		ID:               10000787,
-		Handle:           "acalaevm",
-		Symbol:           "ACA",
-		Name:             "Acala EVM",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 2,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(787)),
+	CFXEVM: {
+		Token:   "https://evm.confluxscan.net/address/{token}",
+		Address: "https://evm.confluxscan.net/address/{address}",
+		Tx:      "https://evm.confluxscan.net/tx/{tx}",
+		Block:   "https://evm.confluxscan.net/block/{block}",
 	},
-	Base().Handle: {
-		ID:               8453,
-		Handle:           "base",
-		Symbol:           "ETH",
-		Name:             "Base",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 12,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(8453)),
+	ACALA: {
+		Token:   "https://acala.subscan.io/system_token_detail?unique_id={token}",
+		Address: "https://acala.subscan.io/account/{address}",
+		Tx:      "https://acala.subscan.io/extrinsic/{tx}",
+		Block:   "https://acala.subscan.io/block/{block}",
+		TokenVariants: []ExplorerVariant{
+			{TokenType: "custom_token", URL: "https://acala.subscan.io/custom_token?customTokenId={token}"},
+		},
 	},
-	Akash().Handle: {
-		ID:               17000118,
-		Handle:           "akash",
-		Symbol:           "AKT",
-		Name:             "Akash",
-		Decimals:         6,
-		BlockTime:        0,
-		MinConfirmations: 7,
-		Blockchain:       "Cosmos",
+	ACALAEVM: {
+		Token:   "https://blockscout.acala.network/token/{token}",
+		Address: "https://blockscout.acala.network/address/{address}",
+		Tx:      "https://blockscout.acala.network/tx/{tx}",
+		Block:   "https://blockscout.acala.network/block/{block}",
 	},
-	Agoric().Handle: {
-		ID:               564,
-		Handle:           "agoric",
-		Symbol:           "BLD",
-		Name:             "Agoric",
-		Decimals:         6,
-		BlockTime:        0,
-		MinConfirmations: 7,
-		Blockchain:       "Cosmos",
+	BASE: {
+		Token:   "https://basescan.org/token/{token}",
+		Address: "https://basescan.org/address/{address}",
+		Tx:      "https://basescan.org/tx/{tx}",
+		Block:   "https://basescan.org/block/{block}",
 	},
-	Axelar().Handle: {
-		ID:               50000118,
-		Handle:           "axelar",
-		Symbol:           "AXL",
-		Name:             "Axelar",
-		Decimals:         6,
-		BlockTime:        0,
-		MinConfirmations: 7,
-		Blockchain:       "Cosmos",
+	AKASH: {
+		Tx:    "https://www.mintscan.io/akash/tx/{tx}",
+		Block: "https://www.mintscan.io/akash/block/{block}",
 	},
-	Juno().Handle: {
-		ID:               30000118,
-		Handle:           "juno",
-		Symbol:           "JUNO",
-		Name:             "Juno",
-		Decimals:         6,
-		BlockTime:        0,
-		MinConfirmations: 7,
-		Blockchain:       "Cosmos",
+	AGORIC: {
+		Tx:    "https://www.mintscan.io/agoric/tx/{tx}",
+		Block: "https://www.mintscan.io/agoric/block/{block}",
 	},
-	Sei().Handle: {
-		ID:               19000118,
-		Handle:           "sei",
-		Symbol:           "SEI",
-		Name:             "Sei",
-		Decimals:         6,
-		BlockTime:        0,
-		MinConfirmations: 0,
-		Blockchain:       "Cosmos",
+	AXELAR: {
+		Tx:    "https://www.mintscan.io/axelar/tx/{tx}",
+		Block: "https://www.mintscan.io/axelar/block/{block}",
 	},
-	Seievm().Handle: {
-		ID:               1329,
-		Handle:           "seievm",
-		Symbol:           "SEI",
-		Name:             "Sei EVM",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 12,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(1329)),
+	JUNO: {
+		Tx:    "https://www.mintscan.io/juno/tx/{tx}",
+		Block: "https://www.mintscan.io/juno/block/{block}",
 	},
-	Neon().Handle: {
-		ID:               245022934,
-		Handle:           "neon",
-		Symbol:           "NEON",
-		Name:             "Neon",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 1,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(245022934)),
+	SEI: {
+		Tx:    "https://www.mintscan.io/sei/tx/{tx}",
+		Block: "https://www.mintscan.io/sei/block/{block}",
 	},
-	Opbnb().Handle: {
-		ID:               204,
-		Handle:           "opbnb",
-		Symbol:           "BNB",
-		Name:             "OpBNB",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 24,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(204)),
+	SEIEVM: {
+		Token:   "https://seitrace.com/token/{token}",
+		Address: "https://seitrace.com/address/{address}",
+		Tx:      "https://seitrace.com/tx/{tx}",
+		Block:   "https://seitrace.com/block/{block}",
 	},
-	Linea().Handle: {
-		ID:               59144,
-		Handle:           "linea",
-		Symbol:           "ETH",
-		Name:             "Linea",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 7,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(59144)),
+	NEON: {
+		Token:   "https://neonscan.org/token/{token}",
+		Address: "https://neonscan.org/address/{address}",
+		Tx:      "https://neonscan.org/tx/{tx}",
+		Block:   "https://neonscan.org/block/{block}",
 	},
-	Gbnb().Handle: {
-		ID:               5600,
-		Handle:           "gbnb",
-		Symbol:           "gBNB",
-		Name:             "BNB Greenfield",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 0,
-		Blockchain:       "Greenfield",
+	OPBNB: {
+		Token:   "https://opbnbscan.com/token/{token}",
+		Address: "https://opbnbscan.com/address/{address}",
+		Tx:      "https://opbnbscan.com/tx/{tx}",
+		Block:   "https://opbnbscan.com/block/{block}",
 	},
-	Mantle().Handle: {
-		ID:               5000,
-		Handle:           "mantle",
-		Symbol:           "MNT",
-		Name:             "Mantle",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 0,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(5000)),
+	LINEA: {
+		Token:   "https://explorer.linea.build/token/{token}",
+		Address: "https://explorer.linea.build/address/{address}",
+		Tx:      "https://explorer.linea.build/tx/{tx}",
+		Block:   "https://explorer.linea.build/block/{block}",
 	},
-	Manta().Handle: {
-		ID:               169,
-		Handle:           "manta",
-		Symbol:           "ETH",
-		Name:             "Manta Pacific",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 0,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(169)),
+	GBNB: {
+		Tx:    "https://greenfieldscan.com/tx/{tx}",
+		Block: "https://greenfieldscan.com/block/{block}",
 	},
-	Zetachain().Handle: {
-		ID:               10007000,
-		Handle:           "zetachain",
-		Symbol:           "ZETA",
-		Name:             "NativeZetaChain",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 0,
-		Blockchain:       "Cosmos",
+	MANTLE: {
+		Token:   "https://explorer.mantle.xyz/address/{token}",
+		Address: "https://explorer.mantle.xyz/address/{address}",
+		Tx:      "https://explorer.mantle.xyz/tx/{tx}",
+		Block:   "https://explorer.mantle.xyz/block/{block}",
 	},
-	Zetaevm().Handle: {
-		ID:               20007000,
-		Handle:           "zetaevm",
-		Symbol:           "ZETA",
-		Name:             "Zeta EVM",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 0,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(7000)),
+	MANTA: {
+		Token:   "https://pacific-explorer.manta.network/token/{token}",
+		Address: "https://pacific-explorer.manta.network/address/{address}",
+		Tx:      "https://pacific-explorer.manta.network/tx/{tx}",
+		Block:   "https://pacific-explorer.manta.network/block/{block}",
 	},
-	Merlin().Handle: {
-		ID:               4200,
-		Handle:           "merlin",
-		Symbol:           "BTC",
-		Name:             "Merlin",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 0,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(4200)),
+	ZETACHAIN: {
+		Token:   "https://explorer.zetachain.com/address/{token}",
+		Address: "https://explorer.zetachain.com/address/{address}",
+		Tx:      "https://explorer.zetachain.com/cosmos/tx/{tx}",
+		Block:   "https://explorer.zetachain.com/cosmos/block/{block}",
 	},
-	Blast().Handle: {
-		ID:               81457,
-		Handle:           "blast",
-		Symbol:           "ETH",
-		Name:             "Blast",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 0,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(81457)),
+	ZETAEVM: {
+		Token:   "https://explorer.zetachain.com/address/{token}",
+		Address: "https://explorer.zetachain.com/address/{address}",
+		Tx:      "https://explorer.zetachain.com/evm/tx/{tx}",
+		Block:   "https://explorer.zetachain.com/evm/block/{block}",
 	},
-	Scroll().Handle: {
-		ID:               534352,
-		Handle:           "scroll",
-		Symbol:           "ETH",
-		Name:             "Scroll",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 0,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(534352)),
+	MERLIN: {
+		Tx:    "https://scan.merlinchain.io/tx/{tx}",
+		Block: "https://scan.merlinchain.io/block/{block}",
 	},
-	Internet_computer().Handle: {
-		ID:               223,
-		Handle:           "internet_computer",
-		Symbol:           "ICP",
-		Name:             "Internet Computer",
-		Decimals:         8,
-		BlockTime:        0,
-		MinConfirmations: 0,
-		Blockchain:       "Internet Computer",
+	BLAST: {
+		Token:   "https://blastscan.io/token/{token}",
+		Address: "https://blastscan.io/address/{address}",
+		Tx:      "https://blastscan.io/tx/{tx}",
+		Block:   "https://blastscan.io/block/{block}",
 	},
-	Bouncebit().Handle: {
-		ID:               6001,
-		Handle:           "bouncebit",
-		Symbol:           "BB",
-		Name:             "BounceBit",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 0,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(6001)),
+	SCROLL: {
+		Token:   "https://scrollscan.com/token/{token}",
+		Address: "https://scrollscan.com/address/{address}",
+		Tx:      "https://scrollscan.com/tx/{tx}",
+		Block:   "https://scrollscan.com/block/{block}",
 	},
-	Zklinknova().Handle: {
-		ID:               810180,
-		Handle:           "zklinknova",
-		Symbol:           "ETH",
-		Name:             "zkLink Nova",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 0,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(810180)),
+	INTERNET_COMPUTER: {
+		Tx: "https://dashboard.internetcomputer.org/transaction/{tx}",
 	},
-	Sonic().Handle: {
-		ID:               10000146,
-		Handle:           "sonic",
-		Symbol:           "S",
-		Name:             "Sonic",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 0,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(146)),
+	BOUNCEBIT: {
+		Tx:    "https://bbscan.io/tx/{tx}",
+		Block: "https://bbscan.io/block/{block}",
 	},
-	Tia().Handle: {
-		ID:               21000118,
-		Handle:           "tia",
-		Symbol:           "TIA",
-		Name:             "Celestia",
-		Decimals:         6,
-		BlockTime:        0,
-		MinConfirmations: 0,
-		Blockchain:       "Cosmos",
+	ZKLINKNOVA: {
+		Token:   "https://explorer.zklink.io/address/{token}",
+		Address: "https://explorer.zklink.io/address/{address}",
+		Tx:      "https://explorer.zklink.io/tx/{tx}",
+		Block:   "https://explorer.zklink.io/block/{block}",
 	},
-	Dydx().Handle: {
-		ID:               22000118,
-		Handle:           "dydx",
-		Symbol:           "DYDX",
-		Name:             "dYdX",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 0,
-		Blockchain:       "Cosmos",
+	SONIC: {
+		Token:   "https://sonicscan.org/token/{token}",
+		Address: "https://sonicscan.org/address/{address}",
+		Tx:      "https://sonicscan.org/tx/{tx}",
+		Block:   "https://sonicscan.org/block/{block}",
 	},
-	Plasma().Handle: {
-		ID:               9745,
-		Handle:           "plasma",
-		Symbol:           "XPL",
-		Name:             "Plasma",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 0,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(9745)),
+	TIA: {
+		Token:   "https://www.mintscan.io/celestia",
+		Address: "https://www.mintscan.io/celestia",
+		Tx:      "https://www.mintscan.io/celestia/tx/{tx}",
+		Block:   "https://www.mintscan.io/celestia/block/{block}",
 	},
-	Monad().Handle: {
-		ID:               10143,
-		Handle:           "monad",
-		Symbol:           "MON",
-		Name:             "Monad",
-		Decimals:         18,
-		BlockTime:        1000,
-		MinConfirmations: 12,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(143)),
+	DYDX: {
+		Token:   "https://www.mintscan.io/dydx",
+		Address: "https://www.mintscan.io/dydx",
+		Tx:      "https://www.mintscan.io/dydx/tx/{tx}",
+		Block:   "https://www.mintscan.io/dydx/block/{block}",
 	},
-	Hyperevm().Handle: {
-		ID:               10000999,
-		Handle:           "hyperevm",
-		Symbol:           "HYPE",
-		Name:             "HyperEVM",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 12,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(999)),
+	PLASMA: {
+		Token:   "https://plasmascan.to/token/{token}",
+		Address: "https://plasmascan.to/address/{address}",
+		Tx:      "https://plasmascan.to/tx/{tx}",
+		Block:   "https://plasmascan.to/block/{block}",
 	},
-	Robinhoodchain().Handle: {
-		ID:               10004663,
-		Handle:           "robinhoodchain",
-		Symbol:           "ETH",
-		Name:             "Robinhood Chain",
-		Decimals:         18,
-		BlockTime:        0,
-		MinConfirmations: 0,
-		Blockchain:       "Ethereum",
-		ChainID:          ptr(uint(4663)),
+	MONAD: {
+		Token:   "https://explorer.monad.xyz/token/{token}",
+		Address: "https://explorer.monad.xyz/address/{address}",
+		Tx:      "https://explorer.monad.xyz/tx/{tx}",
+		Block:   "https://explorer.monad.xyz/block/{block}",
+	},
+	HYPEREVM: {
+		Token:   "https://hyperevmscan.io/token/{token}",
+		Address: "https://hyperevmscan.io/address/{address}",
+		Tx:      "https://hyperevmscan.io/tx/{tx}",
+		Block:   "https://hyperevmscan.io/block/{block}",
 	},
+	ROBINHOODCHAIN: {
+		Token:   "https://robinhoodchain.blockscout.com/token/{token}",
+		Address: "https://robinhoodchain.blockscout.com/address/{address}",
+		Tx:      "https://robinhoodchain.blockscout.com/tx/{tx}",
+		Block:   "https://robinhoodchain.blockscout.com/block/{block}",
+	},
+	ROOTSTOCK: {
+		Token:   "https://explorer.rootstock.io/address/{token}",
+		Address: "https://explorer.rootstock.io/address/{address}",
+		Tx:      "https://explorer.rootstock.io/tx/{tx}",
+		Block:   "https://explorer.rootstock.io/block/{block}",
+	},
+}
+
+// slip44s holds the SLIP-44 coin type of the native asset where it differs from the coin ID
+var slip44s = map[uint]uint{
+	IOTEXEVM:       304,
+	SMARTCHAIN:     714,
+	OPTIMISM:       60,
+	XDAI:           700,
+	AVALANCHEC:     9000,
+	FANTOM:         1007,
+	ARBITRUM:       60,
+	CRONOS:         394,
+	AURORA:         60,
+	KAVAEVM:        459,
+	MEGAETH:        60,
+	MOONBEAM:       1284,
+	KLAYTN:         8217,
+	MOONRIVER:      1285,
+	BOBA:           60,
+	POLYGONZKEVM:   60,
+	ZKSYNC:         60,
+	ACALAEVM:       787,
+	BASE:           60,
+	OPBNB:          714,
+	LINEA:          60,
+	MANTA:          60,
+	MERLIN:         0,
+	BLAST:          60,
+	SCROLL:         60,
+	ZKLINKNOVA:     60,
+	ROBINHOODCHAIN: 60,
+}
+
+var networks = map[string]Network{
+	"arbitrum_sepolia": {
+		Coin: Coin{
+			ID:               ARBITRUM,
+			Handle:           "arbitrum_sepolia",
+			Symbol:           "ARETH",
+			Name:             "Arbitrum Sepolia",
+			Decimals:         18,
+			BlockTime:        0,
+			MinConfirmations: 36,
+			Blockchain:       "Ethereum",
+			ChainID:          ptr(uint(421614)),
+		},
+		Type: Testnet,
+	},
+	"avalanchec_fuji": {
+		Coin: Coin{
+			ID:               AVALANCHEC,
+			Handle:           "avalanchec_fuji",
+			Symbol:           "AVAX",
+			Name:             "Avalanche Fuji C-Chain",
+			Decimals:         18,
+			BlockTime:        0,
+			MinConfirmations: 36,
+			Blockchain:       "Ethereum",
+			ChainID:          ptr(uint(43113)),
+		},
+		Type: Testnet,
+	},
+	"base_sepolia": {
+		Coin: Coin{
+			ID:               BASE,
+			Handle:           "base_sepolia",
+			Symbol:           "ETH",
+			Name:             "Base Sepolia",
+			Decimals:         18,
+			BlockTime:        0,
+			MinConfirmations: 12,
+			Blockchain:       "Ethereum",
+			ChainID:          ptr(uint(84532)),
+		},
+		Type: Testnet,
+	},
+	"holesky": {
+		Coin: Coin{
+			ID:               ETHEREUM,
+			Handle:           "holesky",
+			Symbol:           "ETH",
+			Name:             "Holesky",
+			Decimals:         18,
+			BlockTime:        10000,
+			MinConfirmations: 12,
+			Blockchain:       "Ethereum",
+			ChainID:          ptr(uint(17000)),
+		},
+		Type: Testnet,
+	},
+	"optimism_sepolia": {
+		Coin: Coin{
+			ID:               OPTIMISM,
+			Handle:           "optimism_sepolia",
+			Symbol:           "OETH",
+			Name:             "Optimism Sepolia",
+			Decimals:         18,
+			BlockTime:        0,
+			MinConfirmations: 36,
+			Blockchain:       "Ethereum",
+			ChainID:          ptr(uint(11155420)),
+		},
+		Type: Testnet,
+	},
+	"polygon_amoy": {
+		Coin: Coin{
+			ID:               POLYGON,
+			Handle:           "polygon_amoy",
+			Symbol:           "POL",
+			Name:             "Polygon Amoy",
+			Decimals:         18,
+			BlockTime:        0,
+			MinConfirmations: 12,
+			Blockchain:       "Ethereum",
+			ChainID:          ptr(uint(80002)),
+		},
+		Type: Testnet,
+	},
+	"sepolia": {
+		Coin: Coin{
+			ID:               ETHEREUM,
+			Handle:           "sepolia",
+			Symbol:           "ETH",
+			Name:             "Sepolia",
+			Decimals:         18,
+			BlockTime:        10000,
+			MinConfirmations: 12,
+			Blockchain:       "Ethereum",
+			ChainID:          ptr(uint(11155111)),
+		},
+		Type: Testnet,
+	},
+	"smartchain_testnet": {
+		Coin: Coin{
+			ID:               SMARTCHAIN,
+			Handle:           "smartchain_testnet",
+			Symbol:           "tBNB",
+			Name:             "Smart Chain Testnet",
+			Decimals:         18,
+			BlockTime:        1000,
+			MinConfirmations: 7,
+			Blockchain:       "Ethereum",
+			ChainID:          ptr(uint(97)),
+		},
+		Type: Testnet,
+	},
+	"solana_devnet": {
+		Coin: Coin{
+			ID:               SOLANA,
+			Handle:           "solana_devnet",
+			Symbol:           "SOL",
+			Name:             "Solana Devnet",
+			Decimals:         9,
+			BlockTime:        500,
+			MinConfirmations: 0,
+			Blockchain:       "Solana",
+		},
+		Type: Devnet,
+	},
+	"tron_nile": {
+		Coin: Coin{
+			ID:               TRON,
+			Handle:           "tron_nile",
+			Symbol:           "TRX",
+			Name:             "Tron Nile Testnet",
+			Decimals:         6,
+			BlockTime:        10000,
+			MinConfirmations: 0,
+			Blockchain:       "Tron",
+		},
+		Type: Testnet,
+	},
+}
+
+var networkExplorers = map[string]Explorer{
+	"arbitrum_sepolia": {
+		Token:   "https://sepolia.arbiscan.io/token/{token}",
+		Address: "https://sepolia.arbiscan.io/address/{address}",
+		Tx:      "https://sepolia.arbiscan.io/tx/{tx}",
+		Block:   "https://sepolia.arbiscan.io/block/{block}",
+	},
+	"avalanchec_fuji": {
+		Token:   "https://testnet.snowtrace.io/token/{token}",
+		Address: "https://testnet.snowtrace.io/address/{address}",
+		Tx:      "https://testnet.snowtrace.io/tx/{tx}",
+		Block:   "https://testnet.snowtrace.io/block/{block}",
+	},
+	"base_sepolia": {
+		Token:   "https://sepolia.basescan.org/token/{token}",
+		Address: "https://sepolia.basescan.org/address/{address}",
+		Tx:      "https://sepolia.basescan.org/tx/{tx}",
+		Block:   "https://sepolia.basescan.org/block/{block}",
+	},
+	"holesky": {
+		Token:   "https://holesky.etherscan.io/token/{token}",
+		Address: "https://holesky.etherscan.io/address/{address}",
+		Tx:      "https://holesky.etherscan.io/tx/{tx}",
+		Block:   "https://holesky.etherscan.io/block/{block}",
+	},
+	"optimism_sepolia": {
+		Token:   "https://sepolia-optimism.etherscan.io/token/{token}",
+		Address: "https://sepolia-optimism.etherscan.io/address/{address}",
+		Tx:      "https://sepolia-optimism.etherscan.io/tx/{tx}",
+		Block:   "https://sepolia-optimism.etherscan.io/block/{block}",
+	},
+	"polygon_amoy": {
+		Token:   "https://amoy.polygonscan.com/token/{token}",
+		Address: "https://amoy.polygonscan.com/address/{address}",
+		Tx:      "https://amoy.polygonscan.com/tx/{tx}",
+		Block:   "https://amoy.polygonscan.com/block/{block}",
+	},
+	"sepolia": {
+		Token:   "https://sepolia.etherscan.io/token/{token}",
+		Address: "https://sepolia.etherscan.io/address/{address}",
+		Tx:      "https://sepolia.etherscan.io/tx/{tx}",
+		Block:   "https://sepolia.etherscan.io/block/{block}",
+	},
+	"smartchain_testnet": {
+		Token:   "https://testnet.bscscan.com/token/{token}",
+		Address: "https://testnet.bscscan.com/address/{address}",
+		Tx:      "https://testnet.bscscan.com/tx/{tx}",
+		Block:   "https://testnet.bscscan.com/block/{block}",
+	},
+	"solana_devnet": {
+		Token:   "https://solscan.io/token/{token}?cluster=devnet",
+		Address: "https://solscan.io/account/{address}?cluster=devnet",
+		Tx:      "https://solscan.io/tx/{tx}?cluster=devnet",
+		Block:   "https://solscan.io/block/{block}?cluster=devnet",
+	},
+	"tron_nile": {
+		Token:   "https://nile.tronscan.org/#/token20/{token}",
+		Address: "https://nile.tronscan.org/#/address/{address}",
+		Tx:      "https://nile.tronscan.org/#/transaction/{tx}",
+		Block:   "https://nile.tronscan.org/#/block/{block}",
+	},
+}
+
+var networksByCoin = map[uint][]string{
+	ARBITRUM:   {"arbitrum_sepolia"},
+	AVALANCHEC: {"avalanchec_fuji"},
+	BASE:       {"base_sepolia"},
+	ETHEREUM:   {"holesky", "sepolia"},
+	OPTIMISM:   {"optimism_sepolia"},
+	POLYGON:    {"polygon_amoy"},
+	SMARTCHAIN: {"smartchain_testnet"},
+	SOLANA:     {"solana_devnet"},
+	TRON:       {"tron_nile"},
+}
+
+var networksByChainID = map[uint]string{
+	97:       "smartchain_testnet",
+	17000:    "holesky",
+	43113:    "avalanchec_fuji",
+	80002:    "polygon_amoy",
+	84532:    "base_sepolia",
+	421614:   "arbitrum_sepolia",
+	11155111: "sepolia",
+	11155420: "optimism_sepolia",
+}
+
+var coinsByHandle = map[string]uint{
+	"ethereum":          ETHEREUM,
+	"classic":           CLASSIC,
+	"icon":              ICON,
+	"cosmos":            COSMOS,
+	"ripple":            RIPPLE,
+	"stellar":           STELLAR,
+	"poa":               POA,
+	"tron":              TRON,
+	"fio":               FIO,
+	"nimiq":             NIMIQ,
+	"iotex":             IOTEX,
+	"iotexevm":          IOTEXEVM,
+	"zilliqa":           ZILLIQA,
+	"aion":              AION,
+	"aeternity":         AETERNITY,
+	"kava":              KAVA,
+	"theta":             THETA,
+	"binance":           BINANCE,
+	"vechain":           VECHAIN,
+	"callisto":          CALLISTO,
+	"tomochain":         TOMOCHAIN,
+	"thundertoken":      THUNDERTOKEN,
+	"ontology":          ONTOLOGY,
+	"tezos":             TEZOS,
+	"kin":               KIN,
+	"nebulas":           NEBULAS,
+	"gochain":           GOCHAIN,
+	"wanchain":          WANCHAIN,
+	"waves":             WAVES,
+	"bitcoin":           BITCOIN,
+	"litecoin":          LITECOIN,
+	"doge":              DOGE,
+	"dash":              DASH,
+	"viacoin":           VIACOIN,
+	"groestlcoin":       GROESTLCOIN,
+	"zcash":             ZCASH,
+	"firo":              FIRO,
+	"bitcoincash":       BITCOINCASH,
+	"ravencoin":         RAVENCOIN,
+	"qtum":              QTUM,
+	"zelcash":           ZELCASH,
+	"decred":            DECRED,
+	"algorand":          ALGORAND,
+	"nano":              NANO,
+	"digibyte":          DIGIBYTE,
+	"harmony":           HARMONY,
+	"kusama":            KUSAMA,
+	"polkadot":          POLKADOT,
+	"solana":            SOLANA,
+	"near":              NEAR,
+	"elrond":            ELROND,
+	"smartchain":        SMARTCHAIN,
+	"filecoin":          FILECOIN,
+	"oasis":             OASIS,
+	"monacoin":          MONACOIN,
+	"bitcoingold":       BITCOINGOLD,
+	"eos":               EOS,
+	"terra":             TERRA,
+	"band":              BAND,
+	"neo":               NEO,
+	"cardano":           CARDANO,
+	"nuls":              NULS,
+	"polygon":           POLYGON,
+	"thorchain":         THORCHAIN,
+	"optimism":          OPTIMISM,
+	"xdai":              XDAI,
+	"avalanchec":        AVALANCHEC,
+	"heco":              HECO,
+	"fantom":            FANTOM,
+	"arbitrum":          ARBITRUM,
+	"celo":              CELO,
+	"ronin":             RONIN,
+	"osmosis":           OSMOSIS,
+	"cronos":            CRONOS,
+	"kcc":               KCC,
+	"aurora":            AURORA,
+	"kavaevm":           KAVAEVM,
+	"meter":             METER,
+	"evmos":             EVMOS,
+	"nativeevmos":       NATIVEEVMOS,
+	"okc":               OKC,
+	"cryptoorg":         CRYPTOORG,
+	"aptos":             APTOS,
+	"megaeth":           MEGAETH,
+	"moonbeam":          MOONBEAM,
+	"klaytn":            KLAYTN,
+	"metis":             METIS,
+	"moonriver":         MOONRIVER,
+	"boba":              BOBA,
+	"ton":               TON,
+	"polygonzkevm":      POLYGONZKEVM,
+	"zksync":            ZKSYNC,
+	"sui":               SUI,
+	"stride":            STRIDE,
+	"neutron":           NEUTRON,
+	"stargaze":          STARGAZE,
+	"nativeinjective":   NATIVEINJECTIVE,
+	"cfxevm":            CFXEVM,
+	"acala":             ACALA,
+	"acalaevm":          ACALAEVM,
+	"base":              BASE,
+	"akash":             AKASH,
+	"agoric":            AGORIC,
+	"axelar":            AXELAR,
+	"juno":              JUNO,
+	"sei":               SEI,
+	"seievm":            SEIEVM,
+	"neon":              NEON,
+	"opbnb":             OPBNB,
+	"linea":             LINEA,
+	"gbnb":              GBNB,
+	"mantle":            MANTLE,
+	"manta":             MANTA,
+	"zetachain":         ZETACHAIN,
+	"zetaevm":           ZETAEVM,
+	"merlin":            MERLIN,
+	"blast":             BLAST,
+	"scroll":            SCROLL,
+	"internet_computer": INTERNET_COMPUTER,
+	"bouncebit":         BOUNCEBIT,
+	"zklinknova":        ZKLINKNOVA,
+	"sonic":             SONIC,
+	"tia":               TIA,
+	"dydx":              DYDX,
+	"plasma":            PLASMA,
+	"monad":             MONAD,
+	"hyperevm":          HYPEREVM,
+	"robinhoodchain":    ROBINHOODCHAIN,
+	"rootstock":         ROOTSTOCK,
+}
+
+var coinsBySymbol = map[string][]uint{
+	"ACA":       {ACALA, ACALAEVM},
+	"ADA":       {CARDANO},
+	"AE":        {AETERNITY},
+	"AION":      {AION},
+	"AKT":       {AKASH},
+	"ALGO":      {ALGORAND},
+	"APTOS":     {APTOS},
+	"ARETH":     {ARBITRUM},
+	"ATOM":      {COSMOS},
+	"AURORAETH": {AURORA},
+	"AVAX":      {AVALANCHEC},
+	"AXL":       {AXELAR},
+	"BAND":      {BAND},
+	"BB":        {BOUNCEBIT},
+	"BCH":       {BITCOINCASH},
+	"BLD":       {AGORIC},
+	"BNB":       {OPBNB, BINANCE, SMARTCHAIN},
+	"BOBAETH":   {BOBA},
+	"BTC":       {BITCOIN, MERLIN},
+	"BTG":       {BITCOINGOLD},
+	"CELO":      {CELO},
+	"CFX":       {CFXEVM},
+	"CLO":       {CALLISTO},
+	"CRO":       {CRYPTOORG, CRONOS},
+	"DASH":      {DASH},
+	"DCR":       {DECRED},
+	"DGB":       {DIGIBYTE},
+	"DOGE":      {DOGE},
+	"DOT":       {POLKADOT},
+	"DYDX":      {DYDX},
+	"EOS":       {EOS},
+	"ETC":       {CLASSIC},
+	"ETH":       {ETHEREUM, MANTA, MEGAETH, BASE, LINEA, BLAST, SCROLL, ZKLINKNOVA, ROBINHOODCHAIN},
+	"EVMOS":     {EVMOS, NATIVEEVMOS},
+	"FIL":       {FILECOIN},
+	"FIO":       {FIO},
+	"FIRO":      {FIRO},
+	"FTM":       {FANTOM},
+	"GLMR":      {MOONBEAM},
+	"GO":        {GOCHAIN},
+	"GRS":       {GROESTLCOIN},
+	"HT":        {HECO},
+	"HYPE":      {HYPEREVM},
+	"ICP":       {INTERNET_COMPUTER},
+	"ICX":       {ICON},
+	"INJ":       {NATIVEINJECTIVE},
+	"IOTX":      {IOTEX, IOTEXEVM},
+	"JUNO":      {JUNO},
+	"KAVA":      {KAVA, KAVAEVM},
+	"KCS":       {KCC},
+	"KIN":       {KIN},
+	"KLAY":      {KLAYTN},
+	"KSM":       {KUSAMA},
+	"LTC":       {LITECOIN},
+	"LUNC":      {TERRA},
+	"METIS":     {METIS},
+	"MNT":       {MANTLE},
+	"MON":       {MONAD},
+	"MONA":      {MONACOIN},
+	"MOVR":      {MOONRIVER},
+	"MTR":       {METER},
+	"NAS":       {NEBULAS},
+	"NEAR":      {NEAR},
+	"NEO":       {NEO},
+	"NEON":      {NEON},
+	"NIM":       {NIMIQ},
+	"NTRN":      {NEUTRON},
+	"NULS":      {NULS},
+	"OETH":      {OPTIMISM},
+	"OKT":       {OKC},
+	"ONE":       {HARMONY},
+	"ONT":       {ONTOLOGY},
+	"OSMO":      {OSMOSIS},
+	"POA":       {POA},
+	"POL":       {POLYGON},
+	"QTUM":      {QTUM},
+	"RBTC":      {ROOTSTOCK},
+	"RON":       {RONIN},
+	"ROSE":      {OASIS},
+	"RUNE":      {THORCHAIN},
+	"RVN":       {RAVENCOIN},
+	"S":         {SONIC},
+	"SEI":       {SEIEVM, SEI},
+	"SOL":       {SOLANA},
+	"STARS":     {STARGAZE},
+	"STRD":      {STRIDE},
+	"SUI":       {SUI},
+	"THETA":     {THETA},
+	"TIA":       {TIA},
+	"TOMO":      {TOMOCHAIN},
+	"TON":       {TON},
+	"TRX":       {TRON},
+	"TT":        {THUNDERTOKEN},
+	"VET":       {VECHAIN},
+	"VIA":       {VIACOIN},
+	"WAN":       {WANCHAIN},
+	"WAVES":     {WAVES},
+	"XLM":       {STELLAR},
+	"XNO":       {NANO},
+	"XPL":       {PLASMA},
+	"XRP":       {RIPPLE},
+	"XTZ":       {TEZOS},
+	"ZEC":       {ZCASH},
+	"ZEL":       {ZELCASH},
+	"ZETA":      {ZETACHAIN, ZETAEVM},
+	"ZIL":       {ZILLIQA},
+	"ZKEVM":     {POLYGONZKEVM},
+	"ZKSYNC":    {ZKSYNC},
+	"eGLD":      {ELROND},
+	"gBNB":      {GBNB},
+	"xDAI":      {XDAI},
+}
+
+var coinsByChainID = map[uint]uint{
+	1:          ETHEREUM,
+	10:         OPTIMISM,
+	25:         CRONOS,
+	30:         ROOTSTOCK,
+	56:         SMARTCHAIN,
+	60:         GOCHAIN,
+	61:         CLASSIC,
+	66:         OKC,
+	82:         METER,
+	88:         TOMOCHAIN,
+	99:         POA,
+	100:        XDAI,
+	108:        THUNDERTOKEN,
+	128:        HECO,
+	137:        POLYGON,
+	143:        MONAD,
+	146:        SONIC,
+	169:        MANTA,
+	204:        OPBNB,
+	250:        FANTOM,
+	288:        BOBA,
+	321:        KCC,
+	324:        ZKSYNC,
+	787:        ACALAEVM,
+	820:        CALLISTO,
+	888:        WANCHAIN,
+	999:        HYPEREVM,
+	1030:       CFXEVM,
+	1088:       METIS,
+	1101:       POLYGONZKEVM,
+	1284:       MOONBEAM,
+	1285:       MOONRIVER,
+	1329:       SEIEVM,
+	2020:       RONIN,
+	2222:       KAVAEVM,
+	4200:       MERLIN,
+	4326:       MEGAETH,
+	4663:       ROBINHOODCHAIN,
+	4689:       IOTEXEVM,
+	5000:       MANTLE,
+	6001:       BOUNCEBIT,
+	7000:       ZETAEVM,
+	8217:       KLAYTN,
+	8453:       BASE,
+	9001:       EVMOS,
+	9745:       PLASMA,
+	42161:      ARBITRUM,
+	42220:      CELO,
+	43114:      AVALANCHEC,
+	59144:      LINEA,
+	81457:      BLAST,
+	534352:     SCROLL,
+	810180:     ZKLINKNOVA,
+	245022934:  NEON,
+	1313161554: AURORA,
+}
+
+var coinsByBlockchain = map[string][]uint{
+	"Aeternity":         {AETERNITY},
+	"Aion":              {AION},
+	"Algorand":          {ALGORAND},
+	"Aptos":             {APTOS},
+	"Binance":           {BINANCE},
+	"Bitcoin":           {BITCOIN, LITECOIN, DOGE, DASH, VIACOIN, DIGIBYTE, MONACOIN, FIRO, BITCOINCASH, BITCOINGOLD, RAVENCOIN, QTUM},
+	"Cardano":           {CARDANO},
+	"Cosmos":            {COSMOS, TERRA, CRYPTOORG, KAVA, BAND, AGORIC, NATIVEINJECTIVE, OSMOSIS, ZETACHAIN, AKASH, SEI, STARGAZE, NATIVEEVMOS, TIA, DYDX, JUNO, STRIDE, AXELAR, NEUTRON},
+	"Decred":            {DECRED},
+	"EOS":               {EOS},
+	"ElrondNetwork":     {ELROND},
+	"Ethereum":          {ETHEREUM, CLASSIC, ROOTSTOCK, MANTA, POA, OPBNB, CALLISTO, TOMOCHAIN, POLYGON, OKC, THUNDERTOKEN, CFXEVM, SEIEVM, MERLIN, MEGAETH, MANTLE, BOUNCEBIT, GOCHAIN, BASE, PLASMA, MONAD, METER, CELO, LINEA, BLAST, SCROLL, ZKLINKNOVA, WANCHAIN, CRONOS, OPTIMISM, XDAI, SONIC, FANTOM, BOBA, KCC, ZKSYNC, HECO, ACALAEVM, HYPEREVM, METIS, POLYGONZKEVM, MOONBEAM, MOONRIVER, RONIN, KAVAEVM, ROBINHOODCHAIN, IOTEXEVM, KLAYTN, AVALANCHEC, EVMOS, ARBITRUM, SMARTCHAIN, ZETAEVM, NEON, AURORA},
+	"FIO":               {FIO},
+	"Filecoin":          {FILECOIN},
+	"Greenfield":        {GBNB},
+	"Groestlcoin":       {GROESTLCOIN},
+	"Harmony":           {HARMONY},
+	"Icon":              {ICON},
+	"Internet Computer": {INTERNET_COMPUTER},
+	"IoTeX":             {IOTEX},
+	"Kusama":            {KUSAMA},
+	"NEAR":              {NEAR},
+	"NEO":               {NEO},
+	"NULS":              {NULS},
+	"Nano":              {NANO},
+	"Nebulas":           {NEBULAS},
+	"Nimiq":             {NIMIQ},
+	"OasisNetwork":      {OASIS},
+	"Ontology":          {ONTOLOGY},
+	"Polkadot":          {POLKADOT, ACALA},
+	"Ripple":            {RIPPLE},
+	"Solana":            {SOLANA},
+	"Stellar":           {STELLAR, KIN},
+	"Sui":               {SUI},
+	"Tezos":             {TEZOS},
+	"The Open Network":  {TON},
+	"Theta":             {THETA},
+	"Thorchain":         {THORCHAIN},
+	"Tron":              {TRON},
+	"Vechain":           {VECHAIN},
+	"Waves":             {WAVES},
+	"Zcash":             {ZCASH, ZELCASH},
+	"Zilliqa":           {ZILLIQA},
+}
+
+// ByHandle returns the coin with the given handle, e.g. "ethereum".
+func ByHandle(handle string) (Coin, bool) {
+	id, ok := coinsByHandle[handle]
+	if !ok {
+		return Coin{}, false
+	}
+	return Coins[id], true
+}
+
+// BySymbol returns the coins using the given symbol sorted by ID, e.g. every network with ETH as native currency.
+func BySymbol(symbol string) []Coin {
+	return coinsOf(coinsBySymbol[symbol])
+}
+
+// ByChainID returns the EVM coin with the given EIP155 chain ID.
+func ByChainID(chainID uint) (Coin, bool) {
+	id, ok := coinsByChainID[chainID]
+	if !ok {
+		return Coin{}, false
+	}
+	return Coins[id], true
+}
+
+// ByBlockchain returns the coins of the given blockchain family sorted by ID, e.g. BlockchainCosmos.
+func ByBlockchain(blockchain string) []Coin {
+	return coinsOf(coinsByBlockchain[blockchain])
+}
+
+func coinsOf(ids []uint) []Coin {
+	if len(ids) == 0 {
+		return nil
+	}
+	result := make([]Coin, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, Coins[id])
+	}
+	return result
 }
 
 func Ethereum() Coin {
@@ -3327,10 +3479,15 @@ func Scroll() Coin {
 	return Coins[SCROLL]
 }
 
-func Internet_computer() Coin {
+func InternetComputer() Coin {
 	return Coins[INTERNET_COMPUTER]
 }
 
+// Deprecated: use InternetComputer instead.
+func Internet_computer() Coin {
+	return InternetComputer()
+}
+
 func Bouncebit() Coin {
 	return Coins[BOUNCEBIT]
 }
@@ -3366,3 +3523,7 @@ func Hyperevm() Coin {
 func Robinhoodchain() Coin {
 	return Coins[ROBINHOODCHAIN]
 }
+
+func Rootstock() Coin {
+	return Coins[ROOTSTOCK]
+}
@@ -2,6 +2,7 @@ package coin
 
 import (
 	"fmt"
+	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -13,3 +14,129 @@ func TestCoinsBlockchain(t *testing.T) {
 		assert.Falsef(t, c.Blockchain == "", fmt.Sprintf("chain: %s", c.Handle))
 	}
 }
+
+func TestByHandle(t *testing.T) {
+	c, ok := ByHandle("ethereum")
+	assert.True(t, ok)
+	assert.Equal(t, Ethereum(), c)
+
+	_, ok = ByHandle("Ethereum")
+	assert.False(t, ok)
+	_, ok = ByHandle("")
+	assert.False(t, ok)
+}
+
+func TestBySymbol(t *testing.T) {
+	eth := BySymbol("ETH")
+	assert.Contains(t, eth, Ethereum())
+	assert.Contains(t, eth, Base())
+	assert.Equal(t, []Coin{Bitcoin(), Merlin()}, BySymbol("BTC"))
+	assert.Equal(t, []Coin{Solana()}, BySymbol("SOL"))
+	assert.Nil(t, BySymbol("btc"))
+	assert.Nil(t, BySymbol("UNKNOWN"))
+}
+
+func TestByChainID(t *testing.T) {
+	c, ok := ByChainID(56)
+	assert.True(t, ok)
+	assert.Equal(t, Smartchain(), c)
+
+	_, ok = ByChainID(0)
+	assert.False(t, ok)
+}
+
+func TestByBlockchain(t *testing.T) {
+	cosmos := ByBlockchain(BlockchainCosmos)
+	assert.Contains(t, cosmos, Cosmos())
+	assert.Contains(t, cosmos, Osmosis())
+	assert.Nil(t, ByBlockchain("Unknown"))
+}
+
+// TestIndexesMatchScan checks the generated indexes against a linear scan of Coins.
+func TestIndexesMatchScan(t *testing.T) {
+	for _, c := range Coins {
+		got, ok := ByHandle(c.Handle)
+		assert.True(t, ok, c.Handle)
+		assert.Equal(t, c, got)
+
+		assert.Equal(t, scanCoins(func(o Coin) bool { return o.Symbol == c.Symbol }), BySymbol(c.Symbol), c.Symbol)
+		assert.Equal(t, scanCoins(func(o Coin) bool { return o.Blockchain == c.Blockchain }), ByBlockchain(c.Blockchain), c.Blockchain)
+
+		if c.ChainID != nil {
+			got, ok = ByChainID(*c.ChainID)
+			assert.True(t, ok, c.Handle)
+			assert.Equal(t, c, got)
+		}
+	}
+
+	withChainID := scanCoins(func(c Coin) bool { return c.ChainID != nil })
+	assert.Len(t, coinsByChainID, len(withChainID))
+}
+
+// scanCoins returns the matching coins sorted by ID.
+func scanCoins(match func(Coin) bool) []Coin {
+	var result []Coin
+	for _, c := range Coins {
+		if match(c) {
+			result = append(result, c)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result
+}
+
+func BenchmarkByHandle(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = ByHandle("aurora")
+	}
+}
+
+func BenchmarkByHandleScan(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for _, c := range Coins {
+			if c.Handle == "aurora" {
+				break
+			}
+		}
+	}
+}
+
+func BenchmarkBySymbol(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = BySymbol("ETH")
+	}
+}
+
+func BenchmarkBySymbolScan(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = scanCoins(func(c Coin) bool { return c.Symbol == "ETH" })
+	}
+}
+
+func BenchmarkByChainID(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = ByChainID(1313161554)
+	}
+}
+
+func BenchmarkByChainIDScan(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for _, c := range Coins {
+			if c.ChainID != nil && *c.ChainID == 1313161554 {
+				break
+			}
+		}
+	}
+}
+
+func BenchmarkByBlockchain(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = ByBlockchain(BlockchainCosmos)
+	}
+}
+
+func BenchmarkByBlockchainScan(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = scanCoins(func(c Coin) bool { return c.Blockchain == BlockchainCosmos })
+	}
+}
@@ -0,0 +1,74 @@
+package coin
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// Placeholders replaced in explorer URL templates
+const (
+	explorerToken   = "{token}"
+	explorerAddress = "{address}"
+	explorerTx      = "{tx}"
+	explorerBlock   = "{block}"
+)
+
+// Explorer holds the block explorer URL templates of a coin, defined in coins.yml.
+// Empty templates mean the explorer has no page of that kind.
+type Explorer struct {
+	Token   string
+	Address string
+	Tx      string
+	Block   string
+	// TokenVariants override Token for matching tokens, the first match wins
+	TokenVariants []ExplorerVariant
+}
+
+// ExplorerVariant is a token URL template used instead of the default one when its condition matches.
+type ExplorerVariant struct {
+	// TokenType matches the token type, e.g. ESDT
+	TokenType string
+	// Numeric matches token IDs made of digits only, e.g. TRC10 tokens
+	Numeric bool
+	URL     string
+}
+
+func (v ExplorerVariant) matches(tokenID, tokenType string) bool {
+	if v.TokenType != "" && v.TokenType != tokenType {
+		return false
+	}
+	if v.Numeric {
+		if _, err := strconv.ParseUint(tokenID, 10, 64); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// GetExplorer returns the explorer templates of the coin, testnets have their own explorers.
+func GetExplorer(c Coin) (Explorer, bool) {
+	if NetworkOf(c) != Mainnet {
+		e, ok := networkExplorers[c.Handle]
+		return e, ok
+	}
+	e, ok := explorers[c.ID]
+	return e, ok
+}
+
+// tokenTemplate returns the template of the first matching variant, or the default token template.
+func (e Explorer) tokenTemplate(tokenID, tokenType string) string {
+	for _, v := range e.TokenVariants {
+		if v.matches(tokenID, tokenType) {
+			return v.URL
+		}
+	}
+	return e.Token
+}
+
+func explorerURL(c Coin, template, placeholder, value string) (string, error) {
+	if template == "" {
+		return "", errors.New("no explorer for coin: " + c.Handle)
+	}
+	return strings.ReplaceAll(template, placeholder, value), nil
+}
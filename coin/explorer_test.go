@@ -0,0 +1,127 @@
+package coin
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestExplorer_tokenTemplate(t *testing.T) {
+	e := Explorer{
+		Token: "https://example.com/token/{token}",
+		TokenVariants: []ExplorerVariant{
+			{TokenType: "NFT", URL: "https://example.com/collection/{token}"},
+			{Numeric: true, URL: "https://example.com/asset/{token}"},
+			{TokenType: "BEP2", Numeric: true, URL: "https://example.com/bep2/{token}"},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		tokenID   string
+		tokenType string
+		want      string
+	}{
+		{"default", "0xabc", "", "https://example.com/token/{token}"},
+		{"token type", "0xabc", "NFT", "https://example.com/collection/{token}"},
+		{"numeric", "1002000", "", "https://example.com/asset/{token}"},
+		{"first match wins", "1002000", "NFT", "https://example.com/collection/{token}"},
+		{"numeric with other type", "1002000", "BEP2", "https://example.com/asset/{token}"},
+		{"negative is not numeric", "-1", "", "https://example.com/token/{token}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, e.tokenTemplate(tt.tokenID, tt.tokenType))
+		})
+	}
+}
+
+func TestGetExplorer(t *testing.T) {
+	e, ok := GetExplorer(Tron())
+	assert.True(t, ok)
+	assert.Equal(t, "https://tronscan.io/#/address/{address}", e.Address)
+
+	_, ok = GetExplorer(Coin{ID: 123456789})
+	assert.False(t, ok)
+
+	_, err := GetAddressExploreURL(Coin{ID: 123456789, Handle: "unknown"}, "addr")
+	assert.EqualError(t, err, "no explorer for coin: unknown")
+}
+
+func TestExplorersMatchCoinFile(t *testing.T) {
+	type variant struct {
+		TokenType string `yaml:"tokenType"`
+		Numeric   bool   `yaml:"numeric"`
+		URL       string `yaml:"url"`
+	}
+	type entry struct {
+		ID       uint `yaml:"id"`
+		Explorer *struct {
+			Token         string    `yaml:"token"`
+			Address       string    `yaml:"address"`
+			Tx            string    `yaml:"tx"`
+			Block         string    `yaml:"block"`
+			TokenVariants []variant `yaml:"tokenVariants"`
+		} `yaml:"explorer"`
+	}
+
+	data, err := os.ReadFile(coinFile)
+	require.NoError(t, err)
+	var entries []entry
+	require.NoError(t, yaml.Unmarshal(data, &entries))
+
+	withExplorer := 0
+	for _, want := range entries {
+		got, ok := explorers[want.ID]
+		if want.Explorer == nil {
+			assert.False(t, ok, "coin %d has no explorer in %s", want.ID, coinFile)
+			continue
+		}
+		withExplorer++
+		require.True(t, ok, "coin %d explorer not generated", want.ID)
+		assert.Equal(t, want.Explorer.Token, got.Token)
+		assert.Equal(t, want.Explorer.Address, got.Address)
+		assert.Equal(t, want.Explorer.Tx, got.Tx)
+		assert.Equal(t, want.Explorer.Block, got.Block)
+		require.Len(t, got.TokenVariants, len(want.Explorer.TokenVariants))
+		for i, v := range want.Explorer.TokenVariants {
+			assert.Equal(t, ExplorerVariant{TokenType: v.TokenType, Numeric: v.Numeric, URL: v.URL}, got.TokenVariants[i])
+		}
+	}
+	assert.Equal(t, withExplorer, len(explorers))
+}
+
+func TestExplorerPlaceholders(t *testing.T) {
+	placeholder := regexp.MustCompile(`\{[a-z]+\}`)
+	check := func(t *testing.T, template, allowed string) {
+		for _, p := range placeholder.FindAllString(template, -1) {
+			assert.Equal(t, allowed, p, template)
+		}
+	}
+
+	all := make(map[string]Explorer, len(explorers)+len(networkExplorers))
+	for id, e := range explorers {
+		all[Coins[id].Handle] = e
+	}
+	for handle, e := range networkExplorers {
+		all[handle] = e
+	}
+
+	for id, e := range all {
+		assert.NotEmpty(t, e.Token+e.Address+e.Tx+e.Block, "coin %s has an empty explorer", id)
+		check(t, e.Token, explorerToken)
+		check(t, e.Address, explorerAddress)
+		check(t, e.Tx, explorerTx)
+		check(t, e.Block, explorerBlock)
+		for _, v := range e.TokenVariants {
+			assert.NotEmpty(t, v.URL)
+			assert.True(t, v.TokenType != "" || v.Numeric, "variant of coin %s without condition", id)
+			check(t, v.URL, explorerToken)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package coin
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Format is an encoding of ExportRegistry.
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatYAML Format = "yaml"
+)
+
+// plainCoin has the fields of Coin without its marshal methods.
+type plainCoin Coin
+
+// coinView is the serialized form of a coin, with the fields derived from it.
+// Decoding it into a Coin ignores the derived fields.
+type coinView struct {
+	plainCoin `yaml:",inline"`
+	AssetID   AssetID     `json:"assetId" yaml:"assetId"`
+	EVM       bool        `json:"evm" yaml:"evm"`
+	CAIP2     string      `json:"caip2,omitempty" yaml:"caip2,omitempty"`
+	Network   NetworkType `json:"network" yaml:"network"`
+}
+
+func newCoinView(c Coin) coinView {
+	caip2, _ := c.CAIP2()
+	return coinView{
+		plainCoin: plainCoin(c),
+		AssetID:   c.AssetID(),
+		EVM:       isEVM(c),
+		CAIP2:     caip2,
+		Network:   NetworkOf(c),
+	}
+}
+
+// MarshalJSON encodes the coin with its asset ID, EVM flag, CAIP-2 chain ID and network type.
+func (c Coin) MarshalJSON() ([]byte, error) {
+	return json.Marshal(newCoinView(c))
+}
+
+// MarshalYAML encodes the coin with the same fields as MarshalJSON.
+func (c Coin) MarshalYAML() (interface{}, error) {
+	return newCoinView(c), nil
+}
+
+// ExportRegistry writes the generated coins sorted by ID in the given format.
+// The output is a valid Registry overlay. Like Export, it leaves out testnets and devnets.
+func ExportRegistry(w io.Writer, format Format) error {
+	return NewRegistry().Export(w, format)
+}
+
+// Export writes the coins of the registry sorted by ID in the given format.
+// Only mainnet coins are written: networks such as sepolia share the ID of their mainnet, so they are
+// not part of the registry and an export holding them would not be a valid overlay.
+// Use GetNetworks or GetNetworksByType to marshal them.
+func (r *Registry) Export(w io.Writer, format Format) error {
+	coins := r.Coins()
+	switch format {
+	case FormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(coins)
+	case FormatYAML:
+		encoder := yaml.NewEncoder(w)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(coins); err != nil {
+			return err
+		}
+		return encoder.Close()
+	default:
+		return fmt.Errorf("unsupported export format %q", format)
+	}
+}
@@ -0,0 +1,157 @@
+package coin
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestCoinMarshalJSON(t *testing.T) {
+	data, err := json.Marshal(Ethereum())
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"id": 60,
+		"handle": "ethereum",
+		"symbol": "ETH",
+		"name": "Ethereum",
+		"decimals": 18,
+		"blockTime": 10000,
+		"minConfirmations": 12,
+		"blockchain": "Ethereum",
+		"chainId": 1,
+		"deprecated": false,
+		"assetId": "c60",
+		"evm": true,
+		"caip2": "eip155:1",
+		"network": "mainnet"
+	}`, string(data))
+
+	data, err = json.Marshal(Heco())
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"deprecated":true`)
+
+	data, err = json.Marshal(Nimiq())
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "chainId")
+	assert.NotContains(t, string(data), "caip2")
+	assert.Contains(t, string(data), `"evm":false`)
+
+	sepolia, ok := GetNetwork("sepolia")
+	require.True(t, ok)
+	data, err = json.Marshal(sepolia)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"network":"testnet"`)
+	assert.Contains(t, string(data), `"chainId":11155111`)
+	assert.Contains(t, string(data), `"assetId":"c60@sepolia"`)
+	assert.Contains(t, string(data), `"caip2":"eip155:11155111"`)
+}
+
+func TestCoinMarshalEVMMatchesIsEVM(t *testing.T) {
+	r := NewRegistry()
+	for id, c := range Coins {
+		data, err := json.Marshal(c)
+		require.NoError(t, err)
+		var view struct {
+			EVM bool `json:"evm"`
+		}
+		require.NoError(t, json.Unmarshal(data, &view))
+		assert.Equal(t, IsEVM(id), view.EVM, c.Handle)
+		assert.Equal(t, r.IsEVM(id), view.EVM, c.Handle)
+	}
+}
+
+func TestCoinMarshalYAML(t *testing.T) {
+	data, err := yaml.Marshal(Smartchain())
+	require.NoError(t, err)
+	assert.Equal(t, `id: 20000714
+handle: smartchain
+symbol: BNB
+name: Smart Chain
+decimals: 18
+blockTime: 1000
+minConfirmations: 7
+blockchain: Ethereum
+chainId: 56
+deprecated: false
+assetId: c20000714
+evm: true
+caip2: eip155:56
+network: mainnet
+`, string(data))
+}
+
+func TestCoinRoundTrip(t *testing.T) {
+	for _, c := range []Coin{Ethereum(), Heco(), Near(), Solana()} {
+		data, err := json.Marshal(c)
+		require.NoError(t, err)
+		var fromJSON Coin
+		require.NoError(t, json.Unmarshal(data, &fromJSON))
+		assert.Equal(t, c, fromJSON)
+
+		data, err = yaml.Marshal(c)
+		require.NoError(t, err)
+		var fromYAML Coin
+		require.NoError(t, yaml.Unmarshal(data, &fromYAML))
+		assert.Equal(t, c, fromYAML)
+	}
+}
+
+func TestExportRegistry(t *testing.T) {
+	for _, format := range []Format{FormatJSON, FormatYAML} {
+		t.Run(string(format), func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, ExportRegistry(&buf, format))
+
+			var coins []Coin
+			if format == FormatJSON {
+				require.NoError(t, json.Unmarshal(buf.Bytes(), &coins))
+			} else {
+				require.NoError(t, yaml.Unmarshal(buf.Bytes(), &coins))
+			}
+			require.Len(t, coins, len(Coins))
+			for i, c := range coins {
+				assert.Equal(t, Coins[c.ID], c)
+				assert.Equal(t, Mainnet, NetworkOf(c))
+				if i > 0 {
+					assert.Less(t, coins[i-1].ID, c.ID)
+				}
+			}
+
+			for _, n := range GetNetworksByType(Testnet) {
+				assert.NotContains(t, buf.String(), string(n.AssetID()), "networks are not exported")
+			}
+
+			r := NewRegistry()
+			require.NoError(t, r.Merge(buf.Bytes()), "exports are valid overlays")
+			assert.Equal(t, NewRegistry().Coins(), r.Coins())
+		})
+	}
+
+	assert.Error(t, ExportRegistry(&bytes.Buffer{}, "xml"))
+}
+
+func TestRegistryExport(t *testing.T) {
+	r := NewRegistry()
+	require.NoError(t, r.Merge([]byte(testOverlay)))
+
+	var buf bytes.Buffer
+	require.NoError(t, r.Export(&buf, FormatJSON))
+
+	var coins []map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &coins))
+	require.Len(t, coins, len(Coins)+1)
+	var found bool
+	for _, c := range coins {
+		if c["handle"] == "newchain" {
+			found = true
+			assert.Equal(t, "c10777777", c["assetId"])
+			assert.Equal(t, true, c["evm"])
+			assert.Equal(t, "eip155:777777", c["caip2"])
+		}
+	}
+	assert.True(t, found)
+}
@@ -4,44 +4,52 @@
 package main
 
 import (
-	"html/template"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
 	"os"
-	"os/exec"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
-	"time"
+	"text/template"
 
-	"gopkg.in/yaml.v2"
+	"gopkg.in/yaml.v3"
 )
 
 const (
-	coinFile     = "coin/coins.yml"
-	filename     = "coin/coins.go"
-	templateFile = `// Code generated by go generate; DO NOT EDIT.
-// This file was generated by robots at
-// {{ .Timestamp }}
-// using data from coins.yml
+	coinFile      = "coin/coins.yml"
+	filename      = "coin/coins.go"
+	typesFilename = "types/tokentypes.go"
+	templateFile  = `// Code generated by go generate; DO NOT EDIT.
+// This file was generated by robots using data from coins.yml
 package coin
 
 import (
 	"fmt"
+	"regexp"
 )
 
 const (
-	coinPrefix  = "c"
-	tokenPrefix = "t"
+	coinPrefix       = "c"
+	tokenPrefix      = "t"
+	networkSeparator = "@"
 )
 
 // Coin is the native currency of a blockchain
 type Coin struct {
-	ID               uint
-	Handle           string
-	Symbol           string
-	Name             string
-	Decimals         uint
-	BlockTime        int
-	MinConfirmations int64
-	Blockchain       string // Name of the Blockchain which core is used for this network
-	ChainID   		 *uint // EIP155; Source: https://chainlist.org
+	ID               uint   {{ Tag "id" }}
+	Handle           string {{ Tag "handle" }}
+	Symbol           string {{ Tag "symbol" }}
+	Name             string {{ Tag "name" }}
+	Decimals         uint   {{ Tag "decimals" }}
+	BlockTime        int    {{ Tag "blockTime" }}
+	MinConfirmations int64  {{ Tag "minConfirmations" }}
+	Blockchain       string {{ Tag "blockchain" }} // Name of the Blockchain which core is used for this network
+	ChainID   		 *uint  {{ Tag "chainId,omitempty" }} // EIP155; Source: https://chainlist.org
+	EIP1191          bool   {{ Tag "eip1191,omitempty" }} // Addresses use the chain ID aware EIP1191 checksum instead of EIP55
+	Deprecated       bool   {{ Tag "deprecated" }} // Kept for historical data, not supported anymore
 }
 
 type AssetID string
@@ -50,8 +58,14 @@ func (c *Coin) String() string {
 	return fmt.Sprintf("[%s] %s (#%d)", c.Symbol, c.Name, c.ID)
 }
 
+// AssetID returns the asset ID of the native asset, testnets add their handle, e.g. "c60@sepolia",
+// so it never equals the asset ID of their mainnet.
 func (c Coin) AssetID() AssetID {
-	return AssetID(coinPrefix + fmt.Sprint(c.ID))
+	result := AssetID(coinPrefix + fmt.Sprint(c.ID))
+	if NetworkOf(c) != Mainnet {
+		result += AssetID(networkSeparator + c.Handle)
+	}
+	return result
 }
 
 func (c Coin) TokenAssetID(t string) AssetID {
@@ -67,6 +81,9 @@ func ptr[T any](v T) *T {
 	return &v
 }
 
+// handlePattern is the format of coin handles, checked by the generator and by Registry overlays
+var handlePattern = regexp.MustCompile({{ .HandlePattern | Quote }})
+
 const (
 {{- range .Coins }}
 	{{- if .Deprecated}}
@@ -80,100 +97,846 @@ var Coins = map[uint]Coin{
 {{- range .Coins }}
 	{{ .Handle | ToUpper }}: {
 		ID:               {{.ID}},
-		Handle:           "{{.Handle}}",
-		Symbol:           "{{.Symbol}}",
-		Name:             "{{.Name}}",
+		Handle:           {{ .Handle | Quote }},
+		Symbol:           {{ .Symbol | Quote }},
+		Name:             {{ .Name | Quote }},
 		Decimals:         {{.Decimals}},
 		BlockTime:        {{.BlockTime}},
 		MinConfirmations: {{.MinConfirmations}},
-		Blockchain:       "{{.Blockchain}}",
+		Blockchain:       {{ .Blockchain | Quote }},
 		{{- if .ChainID }}
 		ChainID:   ptr(uint({{.ChainID}})),
 		{{- end }}
+		{{- if .EIP1191 }}
+		EIP1191:          true,
+		{{- end }}
+		{{- if .Deprecated }}
+		Deprecated:       true,
+		{{- end }}
 	},
 {{- end }}
 }
 
 var Chains = map[string]Coin{
 {{- range .Coins }}
-	{{ .Handle | Capitalize }}().Handle: {
-		ID:               {{.ID}},
-		Handle:           "{{.Handle}}",
-		Symbol:           "{{.Symbol}}",
-		Name:             "{{.Name}}",
-		Decimals:         {{.Decimals}},
-		BlockTime:        {{.BlockTime}},
-		MinConfirmations: {{.MinConfirmations}},
-		Blockchain:       "{{.Blockchain}}",
-		{{- if .ChainID }}
-		ChainID:   ptr(uint({{.ChainID}})),
-		{{- end }}	
+	{{ .Handle | Quote }}: Coins[{{ .Handle | ToUpper }}],
+{{- end }}
+}
+
+var explorers = map[uint]Explorer{
+{{- range $coin := .Coins }}
+	{{- with $coin.Explorer }}
+	{{ $coin.Handle | ToUpper }}: {{ template "explorer" . }},
+	{{- end }}
+{{- end }}
+}
+
+// slip44s holds the SLIP-44 coin type of the native asset where it differs from the coin ID
+var slip44s = map[uint]uint{
+{{- range .Coins }}
+	{{- if .SLIP44 }}
+	{{ .Handle | ToUpper }}: {{ .SLIP44 }},
+	{{- end }}
+{{- end }}
+}
+
+var networks = map[string]Network{
+{{- range .Networks }}
+	{{ .Handle | Quote }}: {
+		Coin: Coin{
+			ID:               {{ .Parent.Handle | ToUpper }},
+			Handle:           {{ .Handle | Quote }},
+			Symbol:           {{ .Symbol | Quote }},
+			Name:             {{ .Name | Quote }},
+			Decimals:         {{ .Parent.Decimals }},
+			BlockTime:        {{ .Parent.BlockTime }},
+			MinConfirmations: {{ .Parent.MinConfirmations }},
+			Blockchain:       {{ .Parent.Blockchain | Quote }},
+			{{- if .ChainID }}
+			ChainID:          ptr(uint({{ .ChainID }})),
+			{{- end }}
+			{{- if .Parent.EIP1191 }}
+			EIP1191:          true,
+			{{- end }}
+			{{- if .Parent.Deprecated }}
+			Deprecated:       true,
+			{{- end }}
+		},
+		Type: {{ .Type | Capitalize }},
 	},
 {{- end }}
 }
 
+var networkExplorers = map[string]Explorer{
+{{- range .Networks }}
+	{{- if .Explorer }}
+	{{ .Handle | Quote }}: {{ template "explorer" .Explorer }},
+	{{- end }}
+{{- end }}
+}
+
+var networksByCoin = map[uint][]string{
+{{- range .NetworksByCoin }}
+	{{ .Key | ToUpper }}: { {{- range $i, $handle := .Handles }}{{ if $i }}, {{ end }}{{ $handle | Quote }}{{ end -}} },
+{{- end }}
+}
+
+var networksByChainID = map[uint]string{
+{{- range .NetworksByChainID }}
+	{{ .Key }}: {{ index .Handles 0 | Quote }},
+{{- end }}
+}
+
+var coinsByHandle = map[string]uint{
 {{- range .Coins }}
+	{{ .Handle | Quote }}: {{ .Handle | ToUpper }},
+{{- end }}
+}
 
-func {{ .Handle | Capitalize }}() Coin {
+var coinsBySymbol = map[string][]uint{
+{{- range .BySymbol }}
+	{{ .Key | Quote }}: { {{- range $i, $handle := .Handles }}{{ if $i }}, {{ end }}{{ $handle | ToUpper }}{{ end -}} },
+{{- end }}
+}
+
+var coinsByChainID = map[uint]uint{
+{{- range .ByChainID }}
+	{{ .Key }}: {{ index .Handles 0 | ToUpper }},
+{{- end }}
+}
+
+var coinsByBlockchain = map[string][]uint{
+{{- range .ByBlockchain }}
+	{{ .Key | Quote }}: { {{- range $i, $handle := .Handles }}{{ if $i }}, {{ end }}{{ $handle | ToUpper }}{{ end -}} },
+{{- end }}
+}
+
+// ByHandle returns the coin with the given handle, e.g. "ethereum".
+func ByHandle(handle string) (Coin, bool) {
+	id, ok := coinsByHandle[handle]
+	if !ok {
+		return Coin{}, false
+	}
+	return Coins[id], true
+}
+
+// BySymbol returns the coins using the given symbol sorted by ID, e.g. every network with ETH as native currency.
+func BySymbol(symbol string) []Coin {
+	return coinsOf(coinsBySymbol[symbol])
+}
+
+// ByChainID returns the EVM coin with the given EIP155 chain ID.
+func ByChainID(chainID uint) (Coin, bool) {
+	id, ok := coinsByChainID[chainID]
+	if !ok {
+		return Coin{}, false
+	}
+	return Coins[id], true
+}
+
+// ByBlockchain returns the coins of the given blockchain family sorted by ID, e.g. BlockchainCosmos.
+func ByBlockchain(blockchain string) []Coin {
+	return coinsOf(coinsByBlockchain[blockchain])
+}
+
+func coinsOf(ids []uint) []Coin {
+	if len(ids) == 0 {
+		return nil
+	}
+	result := make([]Coin, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, Coins[id])
+	}
+	return result
+}
+
+{{- range .Coins }}
+
+func {{ .Handle | Identifier }}() Coin {
 	return Coins[{{ .Handle | ToUpper }}]
 }
+{{- if ne (.Handle | Identifier) (.Handle | Capitalize) }}
+
+// Deprecated: use {{ .Handle | Identifier }} instead.
+func {{ .Handle | Capitalize }}() Coin {
+	return {{ .Handle | Identifier }}()
+}
+{{- end }}
 {{- end }}
 
+{{- define "explorer" -}}
+{
+		{{- if .Token }}
+		Token:   {{ .Token | Quote }},
+		{{- end }}
+		{{- if .Address }}
+		Address: {{ .Address | Quote }},
+		{{- end }}
+		{{- if .Tx }}
+		Tx:      {{ .Tx | Quote }},
+		{{- end }}
+		{{- if .Block }}
+		Block:   {{ .Block | Quote }},
+		{{- end }}
+		{{- if .TokenVariants }}
+		TokenVariants: []ExplorerVariant{
+			{{- range .TokenVariants }}
+			{ {{- if .TokenType }}TokenType: {{ .TokenType | Quote }}, {{ end }}{{ if .Numeric }}Numeric: true, {{ end }}URL: {{ .URL | Quote }}},
+			{{- end }}
+		},
+		{{- end }}
+	}
+{{- end -}}
 `
 )
 
+// typesTemplateFile generates the token types of coins.yml into the types package
+const typesTemplateFile = `// Code generated by go generate; DO NOT EDIT.
+// This file was generated by robots using data from coins.yml
+package types
+
+import (
+	"github.com/trustwallet/go-primitives/coin"
+)
+
+const (
+{{- range .TokenTypes }}
+	{{ .ConstName }} TokenType = {{ .Type | Quote }}
+{{- end }}
+)
+
+// EIP155 chain IDs of the EVM coins
+const (
+{{- range .Coins }}
+	{{- if .ChainID }}
+	ChainID{{ . | ChainIDName }} = {{ .ChainID }}
+	{{- end }}
+{{- end }}
+)
+
+var tokenTypes = []TokenType{
+{{- range .ListedTokenTypes }}
+	{{ .ConstName }},
+{{- end }}
+}
+
+var tokenVersions = map[TokenType]TokenVersion{
+{{- range .TokenTypes }}
+	{{ .ConstName }}: {{ with .Version }}TokenVersionV{{ . }}{{ else }}TokenVersionUndefined{{ end }},
+{{- end }}
+}
+
+var tokenTypeCoins = map[TokenType]uint{
+{{- range .TokenTypes }}
+	{{- if not .Unbound }}
+	{{ .ConstName }}: coin.{{ .Coin.Handle | ToUpper }},
+	{{- end }}
+{{- end }}
+}
+
+// indexTokenTypes holds the token type GetEthereumTokenTypeByIndex returns for a coin
+var indexTokenTypes = map[uint]TokenType{
+{{- range .IndexTokenTypes }}
+	coin.{{ .Coin.Handle | ToUpper }}: {{ .Type.ConstName }},
+{{- end }}
+}
+
+var coinTokenTypes = map[uint][]tokenTypeRule{
+{{- range .CoinTokenTypes }}
+	coin.{{ .Coin.Handle | ToUpper }}: {
+		{{- range .Rules }}
+		{Type: {{ .ConstName }}
+			{{- if .Numeric }}, Numeric: true{{ end }}
+			{{- if .Length }}, Length: {{ .Length }}{{ end }}
+			{{- if .Contains }}, Contains: {{ .Contains | Quote }}{{ end -}}
+		},
+		{{- end }}
+	},
+{{- end }}
+}
+`
+
+// CoinTokenTypes holds the token types GetTokenType detects for a coin.
+type CoinTokenTypes struct {
+	Coin  Coin
+	Rules []TokenType
+}
+
+// CoinTokenType is the token type GetEthereumTokenTypeByIndex returns for a coin.
+type CoinTokenType struct {
+	Coin Coin
+	Type TokenType
+}
+
 type Coin struct {
-	ID               uint   `yaml:"id"`
-	Handle           string `yaml:"handle"`
-	Symbol           string `yaml:"symbol"`
-	Name             string `yaml:"name"`
-	Decimals         uint   `yaml:"decimals"`
-	BlockTime        int    `yaml:"blockTime"`
-	MinConfirmations int64  `yaml:"minConfirmations"`
-	Blockchain       string `yaml:"blockchain"`
-	Deprecated       bool   `yaml:"deprecated"`
-	ChainID          *uint  `yaml:"chainId"`
+	ID               uint        `yaml:"id"`
+	Handle           string      `yaml:"handle"`
+	Symbol           string      `yaml:"symbol"`
+	Name             string      `yaml:"name"`
+	Decimals         uint        `yaml:"decimals"`
+	BlockTime        int         `yaml:"blockTime"`
+	MinConfirmations int64       `yaml:"minConfirmations"`
+	Blockchain       string      `yaml:"blockchain"`
+	Deprecated       bool        `yaml:"deprecated"`
+	ChainID          *uint       `yaml:"chainId"`
+	ChainIDName      string      `yaml:"chainIdName"`
+	EIP1191          bool        `yaml:"eip1191"`
+	SLIP44           *uint       `yaml:"slip44"`
+	TokenTypes       []TokenType `yaml:"tokenTypes"`
+	Explorer         *Explorer   `yaml:"explorer"`
+	Networks         []Network   `yaml:"networks"`
 }
 
-func main() {
-	var coinList []Coin
-	coin, err := os.Open(coinFile)
-	dec := yaml.NewDecoder(coin)
-	err = dec.Decode(&coinList)
-	if err != nil {
-		panic(err)
+// TokenType is a token standard of a coin. GetTokenType returns the first detectable
+// token type of the coin whose conditions match the token ID.
+type TokenType struct {
+	Type    string `yaml:"type"`
+	Const   string `yaml:"const"`
+	Version *int   `yaml:"version"`
+	// Shared token types are defined by another coin, e.g. HRC20 of Heco used by Harmony
+	Shared bool `yaml:"shared"`
+	// Detect false excludes the token type from GetTokenType
+	Detect *bool `yaml:"detect"`
+	// Listed false excludes the token type from GetTokenTypes
+	Listed *bool `yaml:"listed"`
+	// ListOrder is the position of a listed token type in GetTokenTypes, starting at 1
+	ListOrder int `yaml:"listOrder"`
+	// Unbound token types are standards of several coins, e.g. ERC721, never detected nor mapped to a coin
+	Unbound bool `yaml:"unbound"`
+	// ByIndex returns the token type from GetEthereumTokenTypeByIndex for a coin that is not EVM,
+	// EVM coins return their token type detected without conditions
+	ByIndex  bool   `yaml:"byIndex"`
+	Numeric  bool   `yaml:"numeric"`
+	Length   int    `yaml:"length"`
+	Contains string `yaml:"contains"`
+	Coin     Coin   `yaml:"-"`
+}
+
+func (t TokenType) ConstName() string {
+	if t.Const != "" {
+		return t.Const
 	}
+	return t.Type
+}
 
-	f, err := os.Create(filename)
+func (t TokenType) IsDetected() bool {
+	return !t.Unbound && (t.Detect == nil || *t.Detect)
+}
+
+func (t TokenType) IsListed() bool {
+	return t.Listed == nil || *t.Listed
+}
+
+func (t TokenType) HasCondition() bool {
+	return t.Numeric || t.Length > 0 || t.Contains != ""
+}
+
+// Network is a testnet or another deployment of a coin, fields left empty are inherited from the coin.
+type Network struct {
+	Handle   string    `yaml:"handle"`
+	Name     string    `yaml:"name"`
+	Type     string    `yaml:"type"`
+	Symbol   string    `yaml:"symbol"`
+	ChainID  *uint     `yaml:"chainId"`
+	Explorer *Explorer `yaml:"explorer"`
+	Parent   Coin      `yaml:"-"`
+}
+
+// Index groups the handles of the coins sharing a key, sorted by coin ID.
+type Index struct {
+	Key     string
+	Handles []string
+}
+
+type Explorer struct {
+	Token         string            `yaml:"token"`
+	Address       string            `yaml:"address"`
+	Tx            string            `yaml:"tx"`
+	Block         string            `yaml:"block"`
+	TokenVariants []ExplorerVariant `yaml:"tokenVariants"`
+}
+
+type ExplorerVariant struct {
+	TokenType string `yaml:"tokenType"`
+	Numeric   bool   `yaml:"numeric"`
+	URL       string `yaml:"url"`
+}
+
+func main() {
+	check := flag.Bool("check", false, "validate the coin file without generating "+filename)
+	input := flag.String("coins", coinFile, "path of the coin file")
+	output := flag.String("out", filename, "path of the generated file")
+	typesOutput := flag.String("types-out", typesFilename, "path of the generated token types file")
+	flag.Parse()
+
+	coinList, problems, err := load(*input)
 	if err != nil {
 		panic(err)
 	}
-	defer f.Close()
+	if len(problems) > 0 {
+		for _, p := range problems {
+			fmt.Fprintf(os.Stderr, "%s:%s\n", *input, p)
+		}
+		os.Exit(1)
+	}
+	if *check {
+		return
+	}
+
+	networkList := networks(coinList)
 
 	funcMap := template.FuncMap{
-		"Capitalize": strings.Title,
-		"ToUpper":    strings.ToUpper,
+		"Capitalize":  strings.Title,
+		"ChainIDName": chainIDName,
+		"Identifier":  identifier,
+		"Quote":       strconv.Quote,
+		"Tag":         tag,
+		"ToUpper":     strings.ToUpper,
 	}
 
 	coinsTemplate := template.Must(template.New("").Funcs(funcMap).Parse(templateFile))
-	err = coinsTemplate.Execute(f, map[string]interface{}{
-		"Timestamp": time.Now(),
-		"Coins":     coinList,
+	var buf bytes.Buffer
+	err = coinsTemplate.Execute(&buf, map[string]interface{}{
+		"Coins":             coinList,
+		"HandlePattern":     handlePattern.String(),
+		"BySymbol":          index(coinList, func(c Coin) string { return c.Symbol }),
+		"ByChainID":         chainIDIndex(coinList),
+		"ByBlockchain":      index(coinList, func(c Coin) string { return c.Blockchain }),
+		"Networks":          networkList,
+		"NetworksByCoin":    networksByCoin(networkList),
+		"NetworksByChainID": chainIDIndex(networkCoins(networkList)),
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	write(*output, buf.Bytes())
+
+	definitions, detected := tokenTypes(coinList)
+	typesTemplate := template.Must(template.New("").Funcs(funcMap).Parse(typesTemplateFile))
+	buf.Reset()
+	err = typesTemplate.Execute(&buf, map[string]interface{}{
+		"Coins":            coinList,
+		"TokenTypes":       definitions,
+		"CoinTokenTypes":   detected,
+		"ListedTokenTypes": listedTokenTypes(definitions),
+		"IndexTokenTypes":  indexTokenTypes(detected),
 	})
 	if err != nil {
 		panic(err)
 	}
-	fmtCommand := exec.Command("go", "fmt", filename)
+	write(*typesOutput, buf.Bytes())
+}
 
-	if err = fmtCommand.Run(); err != nil {
+// write formats the generated code and writes it to path.
+func write(path string, code []byte) {
+	code, err := format.Source(code)
+	if err != nil {
+		panic(err)
+	}
+	if err = os.WriteFile(path, code, 0o644); err != nil {
 		panic(err)
 	}
 }
 
-func getValidParameter(env, variable string) string {
-	e, ok := os.LookupEnv(env)
-	if ok {
-		return e
+// tokenTypes returns the token types defined by the coins and the detectable token types of each coin,
+// shared token types take the const of their definition.
+func tokenTypes(coins []Coin) ([]TokenType, []CoinTokenTypes) {
+	var definitions []TokenType
+	consts := make(map[string]string)
+	for _, c := range coins {
+		for _, tt := range c.TokenTypes {
+			if tt.Shared {
+				continue
+			}
+			tt.Coin = c
+			definitions = append(definitions, tt)
+			consts[tt.Type] = tt.ConstName()
+		}
+	}
+
+	var detected []CoinTokenTypes
+	for _, c := range coins {
+		var rules []TokenType
+		for _, tt := range c.TokenTypes {
+			if !tt.IsDetected() {
+				continue
+			}
+			tt.Const = consts[tt.Type]
+			rules = append(rules, tt)
+		}
+		if len(rules) > 0 {
+			detected = append(detected, CoinTokenTypes{Coin: c, Rules: rules})
+		}
+	}
+	return definitions, detected
+}
+
+// listedTokenTypes returns the listed token types in their list order.
+func listedTokenTypes(definitions []TokenType) []TokenType {
+	var listed []TokenType
+	for _, tt := range definitions {
+		if tt.IsListed() {
+			listed = append(listed, tt)
+		}
+	}
+	sort.SliceStable(listed, func(i, j int) bool { return listed[i].ListOrder < listed[j].ListOrder })
+	return listed
+}
+
+// indexTokenTypes returns the token type of each coin returned by GetEthereumTokenTypeByIndex:
+// the one detected without conditions for EVM coins, the one marked byIndex for other coins.
+func indexTokenTypes(detected []CoinTokenTypes) []CoinTokenType {
+	var result []CoinTokenType
+	for _, d := range detected {
+		for _, tt := range d.Rules {
+			if d.Coin.Blockchain == "Ethereum" && !tt.HasCondition() || tt.ByIndex {
+				result = append(result, CoinTokenType{Coin: d.Coin, Type: tt})
+				break
+			}
+		}
+	}
+	return result
+}
+
+// problem is a validation error of the coin file.
+type problem struct {
+	line int
+	msg  string
+}
+
+func (p problem) String() string {
+	return fmt.Sprintf("%d: %s", p.line, p.msg)
+}
+
+// load decodes the coin file and validates it, problems are sorted by line.
+func load(path string) ([]Coin, []problem, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var doc yaml.Node
+	if err = yaml.Unmarshal(data, &doc); err != nil {
+		return nil, nil, err
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.SequenceNode {
+		return nil, []problem{{line: 1, msg: "expected a list of coins"}}, nil
+	}
+
+	var (
+		coinList []Coin
+		nodes    []*yaml.Node
+		problems []problem
+	)
+	for _, node := range doc.Content[0].Content {
+		var c Coin
+		if err = node.Decode(&c); err != nil {
+			problems = append(problems, problem{line: node.Line, msg: err.Error()})
+			continue
+		}
+		coinList = append(coinList, c)
+		nodes = append(nodes, node)
+	}
+
+	problems = append(problems, validate(coinList, nodes)...)
+	sort.SliceStable(problems, func(i, j int) bool { return problems[i].line < problems[j].line })
+	return coinList, problems, nil
+}
+
+// handlePattern makes handles valid Go identifiers once upper cased, the generated code
+// holds a copy for Registry overlays.
+var handlePattern = regexp.MustCompile(`^[a-z][a-z0-9]*(_[a-z0-9]+)*$`)
+
+// validate reports the problems the generated code would not catch, nodes hold the coins for line numbers.
+func validate(coins []Coin, nodes []*yaml.Node) []problem {
+	var problems []problem
+	report := func(line int, format string, args ...interface{}) {
+		problems = append(problems, problem{line: line, msg: fmt.Sprintf(format, args...)})
 	}
-	return variable
+
+	ids := make(map[uint]int)
+	handles := make(map[string]int)
+	chainIDs := make(map[uint]int)
+	chainIDNames := make(map[string]int)
+	tokenTypes := make(map[string]int)
+	tokenTypeConsts := make(map[string]int)
+	listOrders := make(map[int]int)
+	var sharedTokenTypes []reference
+
+	checkHandle := func(node *yaml.Node, handle string) {
+		line := valueLine(node, "handle")
+		switch {
+		case handle == "":
+			report(line, "missing handle")
+			return
+		case !handlePattern.MatchString(handle):
+			report(line, "handle %q does not produce a valid Go identifier, use lower case letters, digits and single underscores", handle)
+		}
+		if first, ok := handles[handle]; ok {
+			report(line, "duplicate handle %q, first defined at line %d", handle, first)
+			return
+		}
+		handles[handle] = line
+	}
+	checkChainID := func(node *yaml.Node, handle, blockchain string, chainID *uint) {
+		if chainID == nil {
+			if blockchain == "Ethereum" {
+				report(node.Line, "EVM coin %q has no chainId", handle)
+			}
+			return
+		}
+		line := valueLine(node, "chainId")
+		if first, ok := chainIDs[*chainID]; ok {
+			report(line, "duplicate chainId %d, first defined at line %d", *chainID, first)
+			return
+		}
+		chainIDs[*chainID] = line
+	}
+
+	for i, c := range coins {
+		node := nodes[i]
+		if first, ok := ids[c.ID]; ok {
+			report(valueLine(node, "id"), "duplicate id %d, first defined at line %d", c.ID, first)
+		} else {
+			ids[c.ID] = valueLine(node, "id")
+		}
+		checkHandle(node, c.Handle)
+		for _, field := range []struct{ key, value string }{
+			{"symbol", c.Symbol}, {"name", c.Name}, {"blockchain", c.Blockchain},
+		} {
+			if field.value == "" {
+				report(node.Line, "coin %q has no %s", c.Handle, field.key)
+			}
+		}
+		checkChainID(node, c.Handle, c.Blockchain, c.ChainID)
+
+		networkNodes := value(node, "networks")
+		for j, n := range c.Networks {
+			networkNode := networkNodes.Content[j]
+			checkHandle(networkNode, n.Handle)
+			if n.Name == "" {
+				report(networkNode.Line, "network %q has no name", n.Handle)
+			}
+			switch n.Type {
+			case "mainnet", "testnet", "devnet":
+			default:
+				report(valueLine(networkNode, "type"), "network %q has invalid type %q, expected mainnet, testnet or devnet", n.Handle, n.Type)
+			}
+			checkChainID(networkNode, n.Handle, c.Blockchain, n.ChainID)
+		}
+
+		if c.ChainIDName != "" {
+			line := valueLine(node, "chainIdName")
+			switch {
+			case c.ChainID == nil:
+				report(line, "coin %q has a chainIdName without chainId", c.Handle)
+			case !identifierPattern.MatchString(c.ChainIDName):
+				report(line, "chainIdName %q is not a valid Go identifier", c.ChainIDName)
+			}
+		}
+		if c.ChainID != nil {
+			name := "ChainID" + chainIDName(c)
+			if first, ok := chainIDNames[name]; ok {
+				report(valueLine(node, "chainId"), "duplicate constant %s, first defined at line %d", name, first)
+			} else {
+				chainIDNames[name] = valueLine(node, "chainId")
+			}
+		}
+
+		tokenTypeNodes := value(node, "tokenTypes")
+		unconditional := ""
+		byIndex := ""
+		for j, tt := range c.TokenTypes {
+			ttNode := tokenTypeNodes.Content[j]
+			line := valueLine(ttNode, "type")
+			if tt.Type == "" {
+				report(ttNode.Line, "token type of coin %q has no type", c.Handle)
+				continue
+			}
+			if tt.ByIndex {
+				switch {
+				case c.Blockchain == "Ethereum":
+					report(line, "token type %s of EVM coin %q cannot set byIndex, EVM coins use their token type without conditions", tt.Type, c.Handle)
+				case !tt.IsDetected():
+					report(line, "token type %s of coin %q sets byIndex but is never detected", tt.Type, c.Handle)
+				case byIndex != "":
+					report(line, "token type %s of coin %q sets byIndex after %s", tt.Type, c.Handle, byIndex)
+				}
+				byIndex = tt.Type
+			}
+			if unconditional != "" && tt.IsDetected() {
+				report(line, "token type %s of coin %q is never detected after %s without conditions", tt.Type, c.Handle, unconditional)
+			}
+			if tt.IsDetected() && !tt.HasCondition() && unconditional == "" {
+				unconditional = tt.Type
+			}
+			if tt.Shared {
+				if tt.Const != "" || tt.Version != nil || tt.ListOrder != 0 || tt.Unbound {
+					report(line, "shared token type %s cannot set const, version, listOrder or unbound", tt.Type)
+				}
+				sharedTokenTypes = append(sharedTokenTypes, reference{line: line, name: tt.Type})
+				continue
+			}
+			if first, ok := tokenTypes[tt.Type]; ok {
+				report(line, "duplicate token type %s, first defined at line %d, use shared to reuse it", tt.Type, first)
+				continue
+			}
+			tokenTypes[tt.Type] = line
+			switch {
+			case !tt.IsListed():
+				if tt.ListOrder != 0 {
+					report(line, "token type %s is not listed but has a listOrder", tt.Type)
+				}
+			case tt.ListOrder <= 0:
+				report(line, "listed token type %s needs a positive listOrder", tt.Type)
+			default:
+				if first, ok := listOrders[tt.ListOrder]; ok {
+					report(valueLine(ttNode, "listOrder"), "duplicate listOrder %d, first defined at line %d", tt.ListOrder, first)
+				} else {
+					listOrders[tt.ListOrder] = valueLine(ttNode, "listOrder")
+				}
+			}
+			if !identifierPattern.MatchString(tt.ConstName()) {
+				report(line, "token type %s needs a const that is a valid Go identifier", tt.Type)
+			} else if first, ok := tokenTypeConsts[tt.ConstName()]; ok {
+				report(line, "duplicate token type const %s, first defined at line %d", tt.ConstName(), first)
+			} else {
+				tokenTypeConsts[tt.ConstName()] = line
+			}
+		}
+	}
+
+	for _, ref := range sharedTokenTypes {
+		if _, ok := tokenTypes[ref.name]; !ok {
+			report(ref.line, "shared token type %s is not defined by any coin", ref.name)
+		}
+	}
+	return problems
+}
+
+// reference is a use of a name defined elsewhere in the coin file.
+type reference struct {
+	line int
+	name string
+}
+
+var identifierPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// chainIDName returns the suffix of the ChainID constant of the coin, e.g. Ethereum for ChainIDEthereum.
+func chainIDName(c Coin) string {
+	if c.ChainIDName != "" {
+		return c.ChainIDName
+	}
+	return identifier(c.Handle)
+}
+
+// value returns the value of key in a mapping node.
+func value(node *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// valueLine returns the line of the value of key, or the line of the node without key.
+func valueLine(node *yaml.Node, key string) int {
+	if v := value(node, key); v != nil {
+		return v.Line
+	}
+	return node.Line
+}
+
+// identifier returns the exported Go name of a handle, e.g. InternetComputer for internet_computer.
+func identifier(handle string) string {
+	parts := strings.Split(handle, "_")
+	for i, part := range parts {
+		parts[i] = strings.Title(part)
+	}
+	return strings.Join(parts, "")
+}
+
+// tag returns the struct tag of a serialized field, templates are raw strings which cannot hold backquotes.
+func tag(name string) string {
+	return fmt.Sprintf("`json:%q yaml:%q`", name, name)
+}
+
+// networks returns the networks of all coins sorted by handle, with the fields inherited from their coin.
+func networks(coins []Coin) []Network {
+	var result []Network
+	for _, c := range coins {
+		for _, n := range c.Networks {
+			if n.Symbol == "" {
+				n.Symbol = c.Symbol
+			}
+			n.Parent = c
+			result = append(result, n)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Handle < result[j].Handle })
+	return result
+}
+
+// networksByCoin groups the network handles by the handle of their coin.
+func networksByCoin(networks []Network) []Index {
+	handles := make(map[string][]string)
+	for _, n := range networks {
+		handles[n.Parent.Handle] = append(handles[n.Parent.Handle], n.Handle)
+	}
+
+	result := make([]Index, 0, len(handles))
+	for k, h := range handles {
+		result = append(result, Index{Key: k, Handles: h})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Key < result[j].Key })
+	return result
+}
+
+// networkCoins returns the networks as coins to index them like the coins.
+func networkCoins(networks []Network) []Coin {
+	result := make([]Coin, 0, len(networks))
+	for _, n := range networks {
+		result = append(result, Coin{ID: n.Parent.ID, Handle: n.Handle, ChainID: n.ChainID})
+	}
+	return result
+}
+
+// index groups the coins by key, keys and the coins of each key are sorted for a stable output.
+func index(coins []Coin, key func(Coin) string) []Index {
+	sorted := make([]Coin, len(coins))
+	copy(sorted, coins)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].ID < sorted[j].ID })
+
+	handles := make(map[string][]string)
+	for _, c := range sorted {
+		k := key(c)
+		handles[k] = append(handles[k], c.Handle)
+	}
+
+	result := make([]Index, 0, len(handles))
+	for k, h := range handles {
+		result = append(result, Index{Key: k, Handles: h})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Key < result[j].Key })
+	return result
+}
+
+// chainIDIndex maps every EIP155 chain ID to its coin, validate ensures chain IDs are unique.
+func chainIDIndex(coins []Coin) []Index {
+	var withChainID []Coin
+	for _, c := range coins {
+		if c.ChainID != nil {
+			withChainID = append(withChainID, c)
+		}
+	}
+
+	result := index(withChainID, func(c Coin) string { return strconv.FormatUint(uint64(*c.ChainID), 10) })
+	sort.Slice(result, func(i, j int) bool {
+		a, _ := strconv.ParseUint(result[i].Key, 10, 64)
+		b, _ := strconv.ParseUint(result[j].Key, 10, 64)
+		return a < b
+	})
+	return result
 }
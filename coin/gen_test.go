@@ -1,17 +1,21 @@
 package coin
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"os"
+	"os/exec"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
-	"gopkg.in/yaml.v2"
+	"gopkg.in/yaml.v3"
 )
 
 const (
@@ -155,3 +159,119 @@ func TestPublicVariables(t *testing.T) {
 		assert.Equal(t, c, chain)
 	}
 }
+
+func runGenerator(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	if testing.Short() {
+		t.Skip("runs the generator")
+	}
+	cmd := exec.Command("go", append([]string{"run", "-tags=coins", "coin/gen.go"}, args...)...)
+	cmd.Dir = ".."
+	out, err := cmd.CombinedOutput()
+	return string(out), err
+}
+
+func TestGeneratorCheck(t *testing.T) {
+	out, err := runGenerator(t, "-check")
+	assert.NoError(t, err, out)
+	assert.Empty(t, out)
+}
+
+// TestGeneratedFileUpToDate regenerates the generated files to catch hand edits and changes of coins.yml
+// not followed by make generate-coins.
+func TestGeneratedFileUpToDate(t *testing.T) {
+	dir := t.TempDir()
+	out, err := runGenerator(t, "-out", filepath.Join(dir, "coins.go"), "-types-out", filepath.Join(dir, "tokentypes.go"))
+	require.NoError(t, err, out)
+
+	for generated, committed := range map[string]string{
+		"coins.go":      filename,
+		"tokentypes.go": "../types/tokentypes.go",
+	} {
+		want, err := os.ReadFile(committed)
+		require.NoError(t, err)
+		got, err := os.ReadFile(filepath.Join(dir, generated))
+		require.NoError(t, err)
+		assert.True(t, bytes.Equal(want, got), "%s is not up to date, run make generate-coins", committed)
+	}
+}
+
+func TestGeneratorCheckReportsProblems(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "coins.yml")
+	require.NoError(t, os.WriteFile(path, []byte(`- id: 60
+  symbol: ETH
+  handle: ethereum
+  name: Ethereum
+  blockchain: Ethereum
+  chainId: 1
+  networks:
+    - handle: sepolia
+      name: Sepolia
+      type: staging
+      chainId: 1
+
+- id: 60
+  symbol: ETH
+  handle: ethereum
+  name: Ethereum Copy
+  blockchain: Ethereum
+
+- id: 1
+  symbol: X
+  handle: Bad-Handle
+  name: X
+  blockchain: Bitcoin
+
+- id: 2
+  symbol: Y
+  handle: y
+  name: Y
+  blockchain: Bitcoin
+  tokenTypes:
+    - type: Y20
+    - type: Y20
+      detect: false
+    - type: Y21
+      shared: true
+
+- id: 3
+  symbol: Z
+  handle: z
+  name: Z
+  blockchain: Ethereum
+  chainId: 3
+  tokenTypes:
+    - type: Z20
+      listOrder: 1
+      byIndex: true
+    - type: Z21
+      listOrder: 1
+      listed: false
+      detect: false
+    - type: Z22
+      listOrder: 1
+      detect: false
+`), 0o600))
+
+	out, err := runGenerator(t, "-check", "-coins", path)
+	assert.Error(t, err)
+
+	want := []string{
+		path + `:10: network "sepolia" has invalid type "staging", expected mainnet, testnet or devnet`,
+		path + `:11: duplicate chainId 1, first defined at line 6`,
+		path + `:13: duplicate id 60, first defined at line 1`,
+		path + `:13: EVM coin "ethereum" has no chainId`,
+		path + `:15: duplicate handle "ethereum", first defined at line 3`,
+		path + `:21: handle "Bad-Handle" does not produce a valid Go identifier, use lower case letters, digits and single underscores`,
+		path + `:32: duplicate token type Y20, first defined at line 31, use shared to reuse it`,
+		path + `:34: token type Y21 of coin "y" is never detected after Y20 without conditions`,
+		path + `:34: shared token type Y21 is not defined by any coin`,
+		path + `:31: listed token type Y20 needs a positive listOrder`,
+		path + `:44: token type Z20 of EVM coin "z" cannot set byIndex, EVM coins use their token type without conditions`,
+		path + `:47: token type Z21 is not listed but has a listOrder`,
+		path + `:52: duplicate listOrder 1, first defined at line 45`,
+	}
+	for _, line := range want {
+		assert.Contains(t, out, line+"\n")
+	}
+}
@@ -593,6 +593,195 @@ func TestGetAddressExploreURL(t *testing.T) {
 	}
 }
 
+func TestGetTxExploreURL(t *testing.T) {
+	type args struct {
+		hash  string
+		chain Coin
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Test Ethereum",
+			args: args{
+				hash:  "0x5b5e3f5e4a54a6d2d0d5e0d3b2f1a8c0a3d8e0c6f7b8a9e0d1c2b3a4f5e6d7c8",
+				chain: Ethereum(),
+			},
+			want:    "https://etherscan.io/tx/0x5b5e3f5e4a54a6d2d0d5e0d3b2f1a8c0a3d8e0c6f7b8a9e0d1c2b3a4f5e6d7c8",
+			wantErr: false,
+		},
+		{
+			name: "Test Bitcoin",
+			args: args{
+				hash:  "f4184fc596403b9d638783cf57adfe4c75c605f6356fbc91338530e9831e9e16",
+				chain: Bitcoin(),
+			},
+			want:    "https://mempool.space/tx/f4184fc596403b9d638783cf57adfe4c75c605f6356fbc91338530e9831e9e16",
+			wantErr: false,
+		},
+		{
+			name: "Test Cosmos",
+			args: args{
+				hash:  "A1B2C3D4E5F60718293A4B5C6D7E8F90A1B2C3D4E5F60718293A4B5C6D7E8F90",
+				chain: Cosmos(),
+			},
+			want:    "https://www.mintscan.io/cosmos/tx/A1B2C3D4E5F60718293A4B5C6D7E8F90A1B2C3D4E5F60718293A4B5C6D7E8F90",
+			wantErr: false,
+		},
+		{
+			name: "Test TRON",
+			args: args{
+				hash:  "b1f0f4d9e1b2c3a4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c",
+				chain: Tron(),
+			},
+			want:    "https://tronscan.io/#/transaction/b1f0f4d9e1b2c3a4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c",
+			wantErr: false,
+		},
+		{
+			name: "Test Aptos",
+			args: args{
+				hash:  "0x6e5b3d2c1a0f9e8d7c6b5a49382716050f1e2d3c4b5a69788796a5b4c3d2e1f0",
+				chain: Aptos(),
+			},
+			want:    "https://explorer.aptoslabs.com/txn/0x6e5b3d2c1a0f9e8d7c6b5a49382716050f1e2d3c4b5a69788796a5b4c3d2e1f0?network=mainnet",
+			wantErr: false,
+		},
+		{
+			name: "Test unknown coin",
+			args: args{
+				hash:  "f4184fc596403b9d638783cf57adfe4c75c605f6356fbc91338530e9831e9e16",
+				chain: Coin{ID: 123456789, Handle: "unknown"},
+			},
+			want:    "",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetTxExploreURL(tt.args.chain, tt.args.hash)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetTxExploreURL() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetTxExploreURL() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetBlockExploreURL(t *testing.T) {
+	type args struct {
+		height int64
+		chain  Coin
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Test Ethereum",
+			args: args{
+				height: 19000000,
+				chain:  Ethereum(),
+			},
+			want:    "https://etherscan.io/block/19000000",
+			wantErr: false,
+		},
+		{
+			name: "Test Ripple",
+			args: args{
+				height: 85000000,
+				chain:  Ripple(),
+			},
+			want:    "https://xrpscan.com/ledger/85000000",
+			wantErr: false,
+		},
+		{
+			name: "Test NULS",
+			args: args{
+				height: 12345,
+				chain:  Nuls(),
+			},
+			want:    "https://nulscan.io/block/info?height=12345",
+			wantErr: false,
+		},
+		{
+			name: "Test TON masterchain",
+			args: args{
+				height: 38000000,
+				chain:  Ton(),
+			},
+			want:    "https://tonscan.org/block/-1:8000000000000000:38000000",
+			wantErr: false,
+		},
+		{
+			name: "Test negative height",
+			args: args{
+				height: -1,
+				chain:  Ethereum(),
+			},
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name: "Test Nano without blocks",
+			args: args{
+				height: 1,
+				chain:  Nano(),
+			},
+			want:    "",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetBlockExploreURL(tt.args.chain, tt.args.height)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetBlockExploreURL() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetBlockExploreURL() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// coinsWithoutBlockExplorer have no block heights to link to:
+// Nano is a block lattice and the Internet Computer ledger is only browsable by transaction.
+var coinsWithoutBlockExplorer = map[uint]struct{}{
+	NANO:              {},
+	INTERNET_COMPUTER: {},
+}
+
+func TestTxAndBlockExploreURLCoverage(t *testing.T) {
+	for _, c := range Coins {
+		url, err := GetTxExploreURL(c, "hash")
+		if assert.NoError(t, err, c.Handle) {
+			assert.Contains(t, url, "hash", c.Handle)
+		}
+
+		url, err = GetBlockExploreURL(c, 42)
+		if _, ok := coinsWithoutBlockExplorer[c.ID]; ok {
+			assert.Error(t, err, c.Handle)
+			continue
+		}
+		if assert.NoError(t, err, c.Handle) {
+			assert.Contains(t, url, "42", c.Handle)
+		}
+	}
+}
+
 var evmCoinsTestSet = map[uint]struct{}{
 	ETHEREUM:       {},
 	CLASSIC:        {},
@@ -648,6 +837,7 @@ var evmCoinsTestSet = map[uint]struct{}{
 	SEIEVM:         {},
 	HYPEREVM:       {},
 	ROBINHOODCHAIN: {},
+	ROOTSTOCK:      {},
 }
 
 // TestEvmCoinsList This test will automatically fail when new EVM chain is added to coins.yml
@@ -0,0 +1,119 @@
+package coin
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// NetworkType tells mainnets from networks used for testing, defined by the networks of coins.yml.
+type NetworkType string
+
+const (
+	Mainnet NetworkType = "mainnet"
+	Testnet NetworkType = "testnet"
+	Devnet  NetworkType = "devnet"
+)
+
+// Network is a coin on a given network. Testnets keep the ID of their mainnet coin, so Coins and
+// IsEVM resolve it to the mainnet, while Handle, Name, Symbol, ChainID, AssetID and CAIP2 are the
+// network's own, e.g. "c60@sepolia" and "eip155:11155111" for Sepolia. Look networks up with
+// GetNetwork, GetNetworkByChainID, GetNetworkByCAIP2 or GetNetworkByAssetID.
+type Network struct {
+	Coin
+	Type NetworkType
+}
+
+// IsMainnet reports whether the network is the mainnet of its coin.
+func (n Network) IsMainnet() bool {
+	return n.Type == Mainnet
+}
+
+// Mainnet returns the mainnet coin of the network.
+func (n Network) Mainnet() Coin {
+	return Coins[n.ID]
+}
+
+// GetNetwork returns the mainnet or testnet with the given handle, e.g. "ethereum" or "sepolia".
+func GetNetwork(handle string) (Network, bool) {
+	if n, ok := networks[handle]; ok {
+		return n, true
+	}
+	if c, ok := ByHandle(handle); ok {
+		return Network{Coin: c, Type: Mainnet}, true
+	}
+	return Network{}, false
+}
+
+// GetNetworks returns the mainnet of the coin followed by its other networks sorted by handle.
+func GetNetworks(coinID uint) []Network {
+	c, ok := Coins[coinID]
+	if !ok {
+		return nil
+	}
+	result := []Network{{Coin: c, Type: Mainnet}}
+	for _, handle := range networksByCoin[coinID] {
+		result = append(result, networks[handle])
+	}
+	return result
+}
+
+// GetNetworkByChainID returns the mainnet or testnet with the given EIP155 chain ID.
+func GetNetworkByChainID(chainID uint) (Network, bool) {
+	if c, ok := ByChainID(chainID); ok {
+		return Network{Coin: c, Type: Mainnet}, true
+	}
+	if handle, ok := networksByChainID[chainID]; ok {
+		return networks[handle], true
+	}
+	return Network{}, false
+}
+
+// GetNetworkByAssetID returns the mainnet or testnet of a native asset ID, e.g. "c60" or "c60@sepolia".
+func GetNetworkByAssetID(id AssetID) (Network, bool) {
+	if !strings.HasPrefix(string(id), coinPrefix) {
+		return Network{}, false
+	}
+	rawID, handle, isNetwork := strings.Cut(strings.TrimPrefix(string(id), coinPrefix), networkSeparator)
+	coinID, err := strconv.ParseUint(rawID, 10, 32)
+	if err != nil {
+		return Network{}, false
+	}
+	if isNetwork {
+		n, ok := networks[handle]
+		if !ok || n.ID != uint(coinID) {
+			return Network{}, false
+		}
+		return n, true
+	}
+	c, ok := Coins[uint(coinID)]
+	if !ok {
+		return Network{}, false
+	}
+	return Network{Coin: c, Type: Mainnet}, true
+}
+
+// GetNetworksByType returns the networks of the given type sorted by handle.
+func GetNetworksByType(networkType NetworkType) []Network {
+	var result []Network
+	if networkType == Mainnet {
+		for _, c := range Coins {
+			result = append(result, Network{Coin: c, Type: Mainnet})
+		}
+	}
+	for _, n := range networks {
+		if n.Type == networkType {
+			result = append(result, n)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Handle < result[j].Handle })
+	return result
+}
+
+// NetworkOf returns the network type of the coin, coins of the Coins registry are mainnets.
+func NetworkOf(c Coin) NetworkType {
+	if n, ok := networks[c.Handle]; ok && n.ID == c.ID {
+		return n.Type
+	}
+	return Mainnet
+}
@@ -0,0 +1,228 @@
+package coin
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestNetworksMatchCoinFile(t *testing.T) {
+	type network struct {
+		Handle   string    `yaml:"handle"`
+		Name     string    `yaml:"name"`
+		Type     string    `yaml:"type"`
+		Symbol   string    `yaml:"symbol"`
+		ChainID  *uint     `yaml:"chainId"`
+		Explorer *struct{} `yaml:"explorer"`
+	}
+	type entry struct {
+		ID       uint      `yaml:"id"`
+		Symbol   string    `yaml:"symbol"`
+		Networks []network `yaml:"networks"`
+	}
+
+	data, err := os.ReadFile(coinFile)
+	require.NoError(t, err)
+	var entries []entry
+	require.NoError(t, yaml.Unmarshal(data, &entries))
+
+	count := 0
+	for _, e := range entries {
+		for _, want := range e.Networks {
+			count++
+			got, ok := networks[want.Handle]
+			require.True(t, ok, "network %s not generated", want.Handle)
+			assert.Equal(t, e.ID, got.ID)
+			assert.Equal(t, want.Handle, got.Handle)
+			assert.Equal(t, want.Name, got.Name)
+			assert.Equal(t, NetworkType(want.Type), got.Type)
+			assert.Equal(t, want.ChainID, got.ChainID)
+			if want.Symbol == "" {
+				assert.Equal(t, e.Symbol, got.Symbol)
+			} else {
+				assert.Equal(t, want.Symbol, got.Symbol)
+			}
+
+			parent := Coins[e.ID]
+			assert.Equal(t, parent.Decimals, got.Decimals)
+			assert.Equal(t, parent.Blockchain, got.Blockchain)
+			assert.Contains(t, networksByCoin[e.ID], want.Handle)
+
+			_, ok = networkExplorers[want.Handle]
+			assert.Equal(t, want.Explorer != nil, ok, want.Handle)
+		}
+	}
+	assert.Equal(t, count, len(networks))
+}
+
+func TestNetworksDoNotChangeCoins(t *testing.T) {
+	for handle, n := range networks {
+		_, ok := ByHandle(handle)
+		assert.False(t, ok, "network %s shadows a coin", handle)
+		assert.NotEqual(t, Mainnet, n.Type, handle)
+		if n.ChainID != nil {
+			_, ok = ByChainID(*n.ChainID)
+			assert.False(t, ok, "network %s shares the chain id of a coin", handle)
+		}
+	}
+}
+
+func TestGetNetwork(t *testing.T) {
+	sepolia, ok := GetNetwork("sepolia")
+	require.True(t, ok)
+	assert.Equal(t, uint(ETHEREUM), sepolia.ID)
+	assert.Equal(t, Testnet, sepolia.Type)
+	assert.Equal(t, ptr(uint(11155111)), sepolia.ChainID)
+	assert.False(t, sepolia.IsMainnet())
+	assert.Equal(t, Ethereum(), sepolia.Mainnet())
+	assert.Equal(t, AssetID("c60@sepolia"), sepolia.AssetID())
+	assert.Equal(t, AssetID("c60@sepolia_t0xabc"), sepolia.TokenAssetID("0xabc"))
+
+	eth, ok := GetNetwork("ethereum")
+	require.True(t, ok)
+	assert.Equal(t, Network{Coin: Ethereum(), Type: Mainnet}, eth)
+	assert.True(t, eth.IsMainnet())
+
+	devnet, ok := GetNetwork("solana_devnet")
+	require.True(t, ok)
+	assert.Equal(t, Devnet, devnet.Type)
+	assert.Nil(t, devnet.ChainID)
+
+	_, ok = GetNetwork("unknown")
+	assert.False(t, ok)
+}
+
+func TestGetNetworks(t *testing.T) {
+	got := GetNetworks(ETHEREUM)
+	require.Len(t, got, 3)
+	assert.Equal(t, "ethereum", got[0].Handle)
+	assert.Equal(t, "holesky", got[1].Handle)
+	assert.Equal(t, "sepolia", got[2].Handle)
+
+	assert.Equal(t, []Network{{Coin: Bitcoin(), Type: Mainnet}}, GetNetworks(BITCOIN))
+	assert.Nil(t, GetNetworks(123456789))
+}
+
+func TestGetNetworkByChainID(t *testing.T) {
+	tests := []struct {
+		name    string
+		chainID uint
+		want    string
+		wantOk  bool
+	}{
+		{"mainnet", 1, "ethereum", true},
+		{"sepolia", 11155111, "sepolia", true},
+		{"bsc testnet", 97, "smartchain_testnet", true},
+		{"unknown", 999999999, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := GetNetworkByChainID(tt.chainID)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.want, got.Handle)
+		})
+	}
+}
+
+func TestGetNetworksByType(t *testing.T) {
+	testnets := GetNetworksByType(Testnet)
+	assert.Len(t, testnets, len(networks)-len(GetNetworksByType(Devnet)))
+	for _, n := range testnets {
+		assert.Equal(t, Testnet, n.Type)
+	}
+	assert.Len(t, GetNetworksByType(Mainnet), len(Coins))
+}
+
+func TestNetworkOf(t *testing.T) {
+	sepolia, _ := GetNetwork("sepolia")
+	assert.Equal(t, Testnet, NetworkOf(sepolia.Coin))
+	assert.Equal(t, Mainnet, NetworkOf(Ethereum()))
+	assert.Equal(t, Mainnet, NetworkOf(Coin{ID: SOLANA, Handle: "sepolia"}))
+}
+
+func TestNetworkExploreURLs(t *testing.T) {
+	sepolia, _ := GetNetwork("sepolia")
+	url, err := GetTxExploreURL(sepolia.Coin, "0xabc")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://sepolia.etherscan.io/tx/0xabc", url)
+
+	url, err = GetAddressExploreURL(sepolia.Coin, "0x90adE3B7065fa715c7a150313877dF1d33e777D5")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://sepolia.etherscan.io/address/0x90adE3B7065fa715c7a150313877dF1d33e777D5", url)
+
+	devnet, _ := GetNetwork("solana_devnet")
+	url, err = GetBlockExploreURL(devnet.Coin, 42)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://solscan.io/block/42?cluster=devnet", url)
+
+	url, err = GetTxExploreURL(Ethereum(), "0xabc")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://etherscan.io/tx/0xabc", url)
+}
+
+func TestNetworkIdentity(t *testing.T) {
+	sepolia, _ := GetNetwork("sepolia")
+	assert.Equal(t, Ethereum().ID, sepolia.ID)
+	assert.Equal(t, Ethereum(), Coins[sepolia.ID], "the ID resolves to the mainnet coin")
+	assert.True(t, IsEVM(sepolia.ID))
+
+	tests := []struct {
+		handle  string
+		assetID AssetID
+		caip2   string
+	}{
+		{"sepolia", "c60@sepolia", "eip155:11155111"},
+		{"smartchain_testnet", "c20000714@smartchain_testnet", "eip155:97"},
+		{"solana_devnet", "c501@solana_devnet", "solana:EtWTRABZaYq6iMfeYKouRu166VU2xqa1"},
+		{"tron_nile", "c195@tron_nile", "tron:0xcd8690dc"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.handle, func(t *testing.T) {
+			n, ok := GetNetwork(tt.handle)
+			require.True(t, ok)
+			assert.Equal(t, tt.assetID, n.AssetID())
+			caip2, err := n.CAIP2()
+			require.NoError(t, err)
+			assert.Equal(t, tt.caip2, caip2)
+
+			byAssetID, ok := GetNetworkByAssetID(tt.assetID)
+			require.True(t, ok)
+			assert.Equal(t, n, byAssetID)
+
+			byCAIP2, err := GetNetworkByCAIP2(tt.caip2)
+			require.NoError(t, err)
+			assert.Equal(t, n, byCAIP2)
+
+			c, err := GetCoinByCAIP2(tt.caip2)
+			require.NoError(t, err)
+			assert.Equal(t, n.Coin, c)
+		})
+	}
+
+	for _, n := range append(GetNetworksByType(Testnet), GetNetworksByType(Devnet)...) {
+		mainnet := n.Mainnet()
+		assert.NotEqual(t, mainnet.AssetID(), n.AssetID(), n.Handle)
+		if caip2, err := n.CAIP2(); err == nil {
+			mainnetCAIP2, _ := mainnet.CAIP2()
+			assert.NotEqual(t, mainnetCAIP2, caip2, n.Handle)
+			byCAIP2, err := GetNetworkByCAIP2(caip2)
+			assert.NoError(t, err, caip2)
+			assert.Equal(t, n, byCAIP2, caip2)
+		}
+	}
+}
+
+func TestGetNetworkByAssetID(t *testing.T) {
+	eth, ok := GetNetworkByAssetID("c60")
+	require.True(t, ok)
+	assert.Equal(t, Network{Coin: Ethereum(), Type: Mainnet}, eth)
+
+	for _, id := range []AssetID{"", "60", "c", "cabc", "c123456789", "c60@unknown", "c501@sepolia", "c60@"} {
+		_, ok := GetNetworkByAssetID(id)
+		assert.False(t, ok, id)
+	}
+}
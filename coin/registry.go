@@ -0,0 +1,340 @@
+package coin
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+var ErrInvalidOverlay = errors.New("invalid coin overlay")
+
+// Registry is a set of coins starting from the generated Coins that overlays can extend at runtime,
+// e.g. to support a newly launched chain or to tune block times without a library release.
+// It is safe for concurrent use.
+type Registry struct {
+	mu    sync.RWMutex
+	state registryState
+}
+
+type registryState struct {
+	coins     map[uint]Coin
+	explorers map[uint]Explorer
+	byHandle  map[string]uint
+	byChainID map[uint]uint
+}
+
+// overlayCoin is a coin of an overlay, fields left empty keep the value of the registry.
+type overlayCoin struct {
+	ID               *uint            `yaml:"id"`
+	Handle           string           `yaml:"handle"`
+	Symbol           string           `yaml:"symbol"`
+	Name             string           `yaml:"name"`
+	Decimals         *uint            `yaml:"decimals"`
+	BlockTime        *int             `yaml:"blockTime"`
+	MinConfirmations *int64           `yaml:"minConfirmations"`
+	Blockchain       string           `yaml:"blockchain"`
+	ChainID          *uint            `yaml:"chainId"`
+	EIP1191          *bool            `yaml:"eip1191"`
+	Deprecated       *bool            `yaml:"deprecated"`
+	Explorer         *overlayExplorer `yaml:"explorer"`
+
+	// Fields derived from the coin in exports, ignored
+	AssetID string `yaml:"assetId"`
+	EVM     bool   `yaml:"evm"`
+	CAIP2   string `yaml:"caip2"`
+	Network string `yaml:"network"`
+}
+
+type overlayExplorer struct {
+	Token   string `yaml:"token"`
+	Address string `yaml:"address"`
+	Tx      string `yaml:"tx"`
+	Block   string `yaml:"block"`
+}
+
+// NewRegistry returns a registry holding the generated coins.
+func NewRegistry() *Registry {
+	s := registryState{
+		coins:     make(map[uint]Coin, len(Coins)),
+		explorers: make(map[uint]Explorer, len(explorers)),
+	}
+	for id, c := range Coins {
+		s.coins[id] = c
+	}
+	for id, e := range explorers {
+		s.explorers[id] = e
+	}
+	s.index()
+	return &Registry{state: s}
+}
+
+// LoadRegistry returns a registry holding the generated coins merged with the overlay file at path.
+func LoadRegistry(path string) (*Registry, error) {
+	r := NewRegistry()
+	if err := r.MergeFile(path); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// MergeFile merges the overlay file at path, see Merge.
+func (r *Registry) MergeFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return r.Merge(data)
+}
+
+// Merge merges an overlay in the format of coins.yml, given as YAML or JSON. Coins are matched by id:
+// known coins only change the fields set in the overlay, new coins need a handle, symbol, name, decimals,
+// block time and blockchain. Unknown fields are rejected to catch typos such as chainID.
+// The registry is left unchanged when the overlay is invalid.
+func (r *Registry) Merge(data []byte) error {
+	var overlay []overlayCoin
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&overlay); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("%w: %v", ErrInvalidOverlay, err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	s := r.state.clone()
+	for i, o := range overlay {
+		if err := s.merge(o); err != nil {
+			return fmt.Errorf("%w: coin #%d: %v", ErrInvalidOverlay, i, err)
+		}
+	}
+	if err := s.validate(); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidOverlay, err)
+	}
+	s.index()
+	r.state = s
+	return nil
+}
+
+func (s registryState) clone() registryState {
+	c := registryState{
+		coins:     make(map[uint]Coin, len(s.coins)),
+		explorers: make(map[uint]Explorer, len(s.explorers)),
+	}
+	for id, coin := range s.coins {
+		c.coins[id] = coin
+	}
+	for id, e := range s.explorers {
+		c.explorers[id] = e
+	}
+	return c
+}
+
+func (s registryState) merge(o overlayCoin) error {
+	if o.ID == nil {
+		return errors.New("missing id")
+	}
+	c, known := s.coins[*o.ID]
+	if !known {
+		for _, field := range []struct {
+			key string
+			set bool
+		}{
+			{"handle", o.Handle != ""}, {"symbol", o.Symbol != ""}, {"name", o.Name != ""},
+			{"decimals", o.Decimals != nil}, {"blockTime", o.BlockTime != nil}, {"blockchain", o.Blockchain != ""},
+		} {
+			if !field.set {
+				return fmt.Errorf("new coin %d has no %s", *o.ID, field.key)
+			}
+		}
+		c.ID = *o.ID
+	}
+	if o.Handle != "" {
+		c.Handle = o.Handle
+	}
+	if o.Symbol != "" {
+		c.Symbol = o.Symbol
+	}
+	if o.Name != "" {
+		c.Name = o.Name
+	}
+	if o.Decimals != nil {
+		c.Decimals = *o.Decimals
+	}
+	if o.BlockTime != nil {
+		c.BlockTime = *o.BlockTime
+	}
+	if o.MinConfirmations != nil {
+		c.MinConfirmations = *o.MinConfirmations
+	}
+	if o.Blockchain != "" {
+		c.Blockchain = o.Blockchain
+	}
+	if o.ChainID != nil {
+		chainID := *o.ChainID
+		c.ChainID = &chainID
+	}
+	if o.EIP1191 != nil {
+		c.EIP1191 = *o.EIP1191
+	}
+	if o.Deprecated != nil {
+		c.Deprecated = *o.Deprecated
+	}
+	s.coins[c.ID] = c
+
+	if o.Explorer != nil {
+		e := s.explorers[c.ID]
+		for _, field := range []struct {
+			template *string
+			value    string
+		}{
+			{&e.Token, o.Explorer.Token}, {&e.Address, o.Explorer.Address}, {&e.Tx, o.Explorer.Tx}, {&e.Block, o.Explorer.Block},
+		} {
+			if field.value != "" {
+				*field.template = field.value
+			}
+		}
+		s.explorers[c.ID] = e
+	}
+	return nil
+}
+
+func (s *registryState) index() {
+	s.byHandle = make(map[string]uint, len(s.coins))
+	s.byChainID = make(map[uint]uint, len(s.coins))
+	for id, c := range s.coins {
+		s.byHandle[c.Handle] = id
+		if c.ChainID != nil {
+			s.byChainID[*c.ChainID] = id
+		}
+	}
+}
+
+// validate reports the first conflict between coins, checked in ID order to report the same one every time.
+func (s registryState) validate() error {
+	handles := make(map[string]uint)
+	chainIDs := make(map[uint]uint)
+	for _, c := range s.sorted() {
+		if !handlePattern.MatchString(c.Handle) {
+			return fmt.Errorf("coin %d has an invalid handle %q", c.ID, c.Handle)
+		}
+		if first, ok := handles[c.Handle]; ok {
+			return fmt.Errorf("coins %d and %d have the same handle %q", first, c.ID, c.Handle)
+		}
+		if _, ok := networks[c.Handle]; ok {
+			return fmt.Errorf("coin %d uses the handle of network %q", c.ID, c.Handle)
+		}
+		handles[c.Handle] = c.ID
+		if c.ChainID == nil {
+			if isEVM(c) {
+				return fmt.Errorf("EVM coin %q has no chainId", c.Handle)
+			}
+			continue
+		}
+		if first, ok := chainIDs[*c.ChainID]; ok {
+			return fmt.Errorf("coins %d and %d have the same chainId %d", first, c.ID, *c.ChainID)
+		}
+		chainIDs[*c.ChainID] = c.ID
+	}
+	return nil
+}
+
+func (s registryState) sorted() []Coin {
+	result := make([]Coin, 0, len(s.coins))
+	for _, c := range s.coins {
+		result = append(result, c)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result
+}
+
+// Coins returns the coins of the registry sorted by ID.
+func (r *Registry) Coins() []Coin {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.state.sorted()
+}
+
+// Coin returns the coin with the given ID.
+func (r *Registry) Coin(id uint) (Coin, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	c, ok := r.state.coins[id]
+	return c, ok
+}
+
+// ByHandle returns the coin with the given handle, e.g. "ethereum".
+func (r *Registry) ByHandle(handle string) (Coin, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	id, ok := r.state.byHandle[handle]
+	if !ok {
+		return Coin{}, false
+	}
+	return r.state.coins[id], true
+}
+
+// ByChainID returns the EVM coin with the given EIP155 chain ID.
+func (r *Registry) ByChainID(chainID uint) (Coin, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	id, ok := r.state.byChainID[chainID]
+	if !ok {
+		return Coin{}, false
+	}
+	return r.state.coins[id], true
+}
+
+// GetCoinForId is GetCoinForId against the registry.
+func (r *Registry) GetCoinForId(id string) (Coin, error) {
+	if c, ok := r.ByHandle(id); ok {
+		return c, nil
+	}
+	return Coin{}, errors.New("unknown id " + id)
+}
+
+// IsEVM is IsEVM against the registry.
+func (r *Registry) IsEVM(coinID uint) bool {
+	c, _ := r.Coin(coinID)
+	return isEVM(c)
+}
+
+// GetExplorer is GetExplorer against the registry, testnets keep their generated explorers.
+func (r *Registry) GetExplorer(c Coin) (Explorer, bool) {
+	if NetworkOf(c) != Mainnet {
+		return GetExplorer(c)
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	e, ok := r.state.explorers[c.ID]
+	return e, ok
+}
+
+// GetCoinExploreURL is GetCoinExploreURL against the registry.
+func (r *Registry) GetCoinExploreURL(c Coin, tokenID, tokenType string) (string, error) {
+	e, _ := r.GetExplorer(c)
+	return coinExploreURL(c, e, tokenID, tokenType)
+}
+
+// GetAddressExploreURL is GetAddressExploreURL against the registry.
+func (r *Registry) GetAddressExploreURL(c Coin, address string) (string, error) {
+	e, _ := r.GetExplorer(c)
+	return addressExploreURL(c, e, address)
+}
+
+// GetTxExploreURL is GetTxExploreURL against the registry.
+func (r *Registry) GetTxExploreURL(c Coin, hash string) (string, error) {
+	e, _ := r.GetExplorer(c)
+	return txExploreURL(c, e, hash)
+}
+
+// GetBlockExploreURL is GetBlockExploreURL against the registry.
+func (r *Registry) GetBlockExploreURL(c Coin, height int64) (string, error) {
+	e, _ := r.GetExplorer(c)
+	return blockExploreURL(c, e, height)
+}
@@ -0,0 +1,196 @@
+package coin
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testOverlay = `
+- id: 60
+  blockTime: 12000
+  minConfirmations: 64
+- id: 10777777
+  handle: newchain
+  symbol: NEW
+  name: New Chain
+  decimals: 18
+  blockTime: 2000
+  minConfirmations: 5
+  blockchain: Ethereum
+  chainId: 777777
+  explorer:
+    address: https://explorer.newchain.io/address/{address}
+    tx: https://explorer.newchain.io/tx/{tx}
+    block: https://explorer.newchain.io/block/{block}
+`
+
+func TestNewRegistry(t *testing.T) {
+	r := NewRegistry()
+	assert.Len(t, r.Coins(), len(Coins))
+
+	c, ok := r.Coin(ETHEREUM)
+	assert.True(t, ok)
+	assert.Equal(t, Ethereum(), c)
+
+	c, ok = r.ByHandle("smartchain")
+	assert.True(t, ok)
+	assert.Equal(t, Smartchain(), c)
+
+	c, ok = r.ByChainID(56)
+	assert.True(t, ok)
+	assert.Equal(t, Smartchain(), c)
+
+	_, ok = r.Coin(10777777)
+	assert.False(t, ok)
+}
+
+func TestRegistryMerge(t *testing.T) {
+	r := NewRegistry()
+	require.NoError(t, r.Merge([]byte(testOverlay)))
+
+	eth, ok := r.Coin(ETHEREUM)
+	require.True(t, ok)
+	assert.Equal(t, 12000, eth.BlockTime)
+	assert.Equal(t, int64(64), eth.MinConfirmations)
+	assert.Equal(t, Ethereum().Name, eth.Name)
+	assert.Equal(t, Ethereum().ChainID, eth.ChainID)
+	assert.Equal(t, 10000, Ethereum().BlockTime, "the generated coins are left unchanged")
+
+	c, ok := r.ByHandle("newchain")
+	require.True(t, ok)
+	assert.Equal(t, uint(10777777), c.ID)
+	assert.Equal(t, "NEW", c.Symbol)
+	assert.Equal(t, uint(18), c.Decimals)
+	assert.Equal(t, 2000, c.BlockTime)
+	assert.Equal(t, int64(5), c.MinConfirmations)
+	assert.True(t, r.IsEVM(c.ID))
+	assert.False(t, IsEVM(c.ID))
+
+	byChainID, ok := r.ByChainID(777777)
+	require.True(t, ok)
+	assert.Equal(t, c, byChainID)
+
+	byID, err := r.GetCoinForId("newchain")
+	require.NoError(t, err)
+	assert.Equal(t, c, byID)
+	_, err = GetCoinForId("newchain")
+	assert.Error(t, err)
+
+	assert.Len(t, r.Coins(), len(Coins)+1)
+}
+
+func TestRegistryMergeJSON(t *testing.T) {
+	r := NewRegistry()
+	require.NoError(t, r.Merge([]byte(`[{"id": 501, "minConfirmations": 32, "explorer": {"tx": "https://solscan.io/tx/{tx}?cluster=mainnet"}}]`)))
+
+	c, ok := r.Coin(SOLANA)
+	require.True(t, ok)
+	assert.Equal(t, int64(32), c.MinConfirmations)
+
+	url, err := r.GetTxExploreURL(c, "abc")
+	require.NoError(t, err)
+	assert.Equal(t, "https://solscan.io/tx/abc?cluster=mainnet", url)
+
+	url, err = r.GetAddressExploreURL(c, "def")
+	require.NoError(t, err)
+	want, _ := GetAddressExploreURL(Solana(), "def")
+	assert.Equal(t, want, url, "templates missing from the overlay are kept")
+}
+
+func TestRegistryExploreURLs(t *testing.T) {
+	r := NewRegistry()
+	require.NoError(t, r.Merge([]byte(testOverlay)))
+	c, _ := r.ByHandle("newchain")
+
+	url, err := r.GetAddressExploreURL(c, "0x1")
+	require.NoError(t, err)
+	assert.Equal(t, "https://explorer.newchain.io/address/0x1", url)
+
+	url, err = r.GetTxExploreURL(c, "0x2")
+	require.NoError(t, err)
+	assert.Equal(t, "https://explorer.newchain.io/tx/0x2", url)
+
+	url, err = r.GetBlockExploreURL(c, 3)
+	require.NoError(t, err)
+	assert.Equal(t, "https://explorer.newchain.io/block/3", url)
+
+	_, err = r.GetBlockExploreURL(c, -1)
+	assert.Error(t, err)
+
+	_, err = r.GetCoinExploreURL(c, "0x4", "")
+	assert.Error(t, err, "the overlay has no token template")
+
+	_, err = GetTxExploreURL(c, "0x2")
+	assert.Error(t, err, "package-level helpers use the generated explorers")
+
+	sepolia, ok := GetNetwork("sepolia")
+	require.True(t, ok)
+	url, err = r.GetTxExploreURL(sepolia.Coin, "0x5")
+	require.NoError(t, err)
+	want, _ := GetTxExploreURL(sepolia.Coin, "0x5")
+	assert.Equal(t, want, url)
+
+	eth, _ := r.Coin(ETHEREUM)
+	url, err = r.GetCoinExploreURL(eth, "0x6", "ERC20")
+	require.NoError(t, err)
+	want, _ = GetCoinExploreURL(Ethereum(), "0x6", "ERC20")
+	assert.Equal(t, want, url)
+}
+
+func TestRegistryMergeEmpty(t *testing.T) {
+	r := NewRegistry()
+	require.NoError(t, r.Merge(nil))
+	require.NoError(t, r.Merge([]byte("# no changes\n")))
+	assert.Equal(t, NewRegistry().Coins(), r.Coins())
+}
+
+func TestRegistryMergeInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		overlay string
+		wantErr string
+	}{
+		{"syntax", "- id: [", ""},
+		{"missing id", "- blockTime: 1", "missing id"},
+		{"unknown field", "- id: 60\n  chainID: 777777", "field chainID not found"},
+		{"unknown explorer field", "- id: 60\n  explorer:\n    txs: https://etherscan.io/tx/{tx}", "field txs not found"},
+		{"new coin without handle", "- id: 10777777\n  symbol: NEW\n  name: New\n  decimals: 18\n  blockTime: 2000\n  blockchain: Ethereum\n  chainId: 777777", "has no handle"},
+		{"new coin without decimals", "- id: 10777777\n  handle: newchain\n  symbol: NEW\n  name: New\n  blockTime: 2000\n  blockchain: Ethereum\n  chainId: 777777", "has no decimals"},
+		{"new coin without blockTime", "- id: 10777777\n  handle: newchain\n  symbol: NEW\n  name: New\n  decimals: 18\n  blockchain: Ethereum\n  chainId: 777777", "has no blockTime"},
+		{"new coin without blockchain", "- id: 10777777\n  handle: newchain\n  symbol: NEW\n  name: New\n  decimals: 18\n  blockTime: 2000", "has no blockchain"},
+		{"invalid handle", "- id: 60\n  handle: New-Chain", "invalid handle"},
+		{"duplicate handle", "- id: 60\n  handle: smartchain", "same handle \"smartchain\""},
+		{"network handle", "- id: 60\n  handle: sepolia", "handle of network \"sepolia\""},
+		{"duplicate chainId", "- id: 60\n  chainId: 56", "same chainId 56"},
+		{"evm without chainId", "- id: 10777777\n  handle: newchain\n  symbol: NEW\n  name: New\n  decimals: 18\n  blockTime: 2000\n  blockchain: Ethereum", "has no chainId"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRegistry()
+			err := r.Merge([]byte(tt.overlay))
+			assert.ErrorIs(t, err, ErrInvalidOverlay)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.Len(t, r.Coins(), len(Coins))
+			eth, _ := r.Coin(ETHEREUM)
+			assert.Equal(t, Ethereum(), eth, "the registry is left unchanged")
+		})
+	}
+}
+
+func TestLoadRegistry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "overlay.yml")
+	require.NoError(t, os.WriteFile(path, []byte(testOverlay), 0o600))
+
+	r, err := LoadRegistry(path)
+	require.NoError(t, err)
+	_, ok := r.ByHandle("newchain")
+	assert.True(t, ok)
+
+	_, err = LoadRegistry(filepath.Join(t.TempDir(), "missing.yml"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/trustwallet/go-primitives/address"
+	"github.com/trustwallet/go-primitives/coin"
+)
+
+var ErrInvalidCAIP10 = errors.New("invalid CAIP-10 account ID")
+
+// caip10AddressRegexp is the account_address grammar of CAIP-10
+var caip10AddressRegexp = regexp.MustCompile(`^[-.%a-zA-Z0-9]{1,128}$`)
+
+// cashAddrPrefix is the CashAddr prefix of normalized Bitcoin Cash addresses, CAIP-10 has no room for its ':'
+const cashAddrPrefix = "bitcoincash:"
+
+// CAIP10 returns the CAIP-10 account ID of the subscription, e.g. "eip155:1:0xab16...".
+// Valid addresses use the form of address.Normalize, e.g. ronin: addresses become 0x addresses,
+// without the CashAddr prefix of Bitcoin Cash.
+func (v *Subscription) CAIP10() (string, error) {
+	c, ok := coin.Coins[v.Coin]
+	if !ok {
+		return "", fmt.Errorf("%w: unknown coin %d", ErrInvalidCAIP10, v.Coin)
+	}
+	chainID, err := c.CAIP2()
+	if err != nil {
+		return "", err
+	}
+
+	addr := v.Address
+	if normalized, err := address.Normalize(v.Coin, addr); err == nil {
+		addr = normalized
+	}
+	if v.Coin == coin.BITCOINCASH {
+		addr = strings.TrimPrefix(addr, cashAddrPrefix)
+	}
+	if !caip10AddressRegexp.MatchString(addr) {
+		return "", fmt.Errorf("%w: address %q", ErrInvalidCAIP10, v.Address)
+	}
+	return chainID + ":" + addr, nil
+}
+
+// ParseCAIP10 parses a CAIP-10 account ID into a subscription.
+func ParseCAIP10(accountID string) (Subscription, error) {
+	sep := strings.LastIndexByte(accountID, ':')
+	if sep < 0 || !caip10AddressRegexp.MatchString(accountID[sep+1:]) {
+		return Subscription{}, fmt.Errorf("%w: %s", ErrInvalidCAIP10, accountID)
+	}
+	c, err := coin.GetCoinByCAIP2(accountID[:sep])
+	if err != nil {
+		return Subscription{}, err
+	}
+	return Subscription{Coin: c.ID, Address: accountID[sep+1:]}, nil
+}
+
+// AddressIDToCAIP10 converts a "<coin>_<address>" address ID to a CAIP-10 account ID.
+func AddressIDToCAIP10(addressID string) (string, error) {
+	rawCoin, addr, ok := strings.Cut(addressID, "_")
+	if !ok {
+		return "", fmt.Errorf("%w: address id %s", ErrInvalidCAIP10, addressID)
+	}
+	coinID, err := strconv.ParseUint(rawCoin, 10, 32)
+	if err != nil {
+		return "", fmt.Errorf("%w: address id %s", ErrInvalidCAIP10, addressID)
+	}
+	sub := Subscription{Coin: uint(coinID), Address: addr}
+	return sub.CAIP10()
+}
+
+// CAIP10ToAddressID converts a CAIP-10 account ID to a "<coin>_<address>" address ID.
+func CAIP10ToAddressID(accountID string) (string, error) {
+	sub, err := ParseCAIP10(accountID)
+	if err != nil {
+		return "", err
+	}
+	return sub.AddressID(), nil
+}
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/trustwallet/go-primitives/coin"
+)
+
+func TestSubscription_CAIP10(t *testing.T) {
+	tests := []struct {
+		name    string
+		sub     Subscription
+		want    string
+		wantErr error
+	}{
+		{"ethereum", Subscription{Coin: coin.ETHEREUM, Address: "0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb"}, "eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", nil},
+		{"ronin prefix", Subscription{Coin: coin.RONIN, Address: "ronin:ea674fdde714fd979de3edf0f56aa9716b898ec8"}, "eip155:2020:0xEA674fdDe714fd979de3EdF0F56AA9716B898ec8", nil},
+		{"lower case ethereum", Subscription{Coin: coin.ETHEREUM, Address: "0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb"}, "eip155:1:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", nil},
+		{"cosmos", Subscription{Coin: coin.COSMOS, Address: "cosmos1hsk6jryyqjfhp5dhc55tc9jtckygx0eph6dd02"}, "cosmos:cosmoshub-4:cosmos1hsk6jryyqjfhp5dhc55tc9jtckygx0eph6dd02", nil},
+		{"bitcoin cash", Subscription{Coin: coin.BITCOINCASH, Address: "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a"}, "bip122:000000000000000000651ef99cb9fcbe:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a", nil},
+		{"bitcoin cash without prefix", Subscription{Coin: coin.BITCOINCASH, Address: "qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a"}, "bip122:000000000000000000651ef99cb9fcbe:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a", nil},
+		{"invalid characters", Subscription{Coin: coin.ETHEREUM, Address: "0xab16_a96D"}, "", ErrInvalidCAIP10},
+		{"unknown coin", Subscription{Coin: 123456, Address: "0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb"}, "", ErrInvalidCAIP10},
+		{"unsupported chain", Subscription{Coin: coin.NIMIQ, Address: "NQ07"}, "", coin.ErrUnsupportedCAIP2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.sub.CAIP10()
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseCAIP10(t *testing.T) {
+	sub, err := ParseCAIP10("eip155:56:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb")
+	assert.NoError(t, err)
+	assert.Equal(t, Subscription{Coin: coin.SMARTCHAIN, Address: "0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb"}, sub)
+
+	_, err = ParseCAIP10("0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb")
+	assert.ErrorIs(t, err, ErrInvalidCAIP10)
+
+	_, err = ParseCAIP10("eip155:1:")
+	assert.ErrorIs(t, err, ErrInvalidCAIP10)
+
+	_, err = ParseCAIP10("eip155:999999999:0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb")
+	assert.ErrorIs(t, err, coin.ErrUnsupportedCAIP2)
+}
+
+func TestAddressIDCAIP10(t *testing.T) {
+	accountID, err := AddressIDToCAIP10("501_EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v")
+	assert.NoError(t, err)
+	assert.Equal(t, "solana:5eykt4UsFv8P8NJdTREpY1vzqKqZKvdp:EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", accountID)
+
+	addressID, err := CAIP10ToAddressID(accountID)
+	assert.NoError(t, err)
+	assert.Equal(t, "501_EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v", addressID)
+
+	_, err = AddressIDToCAIP10("EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v")
+	assert.ErrorIs(t, err, ErrInvalidCAIP10)
+
+	_, err = AddressIDToCAIP10("sol_EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v")
+	assert.ErrorIs(t, err, ErrInvalidCAIP10)
+
+	accountID, err = AddressIDToCAIP10("145_bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a")
+	assert.NoError(t, err)
+	assert.Equal(t, "bip122:000000000000000000651ef99cb9fcbe:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a", accountID)
+
+	addressID, err = CAIP10ToAddressID(accountID)
+	assert.NoError(t, err)
+	assert.Equal(t, "145_bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a", addressID, "address IDs keep the normalized form")
+
+	_, err = CAIP10ToAddressID("solana:mainnet:EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v")
+	assert.ErrorIs(t, err, coin.ErrUnsupportedCAIP2)
+}
@@ -6,198 +6,10 @@ import (
 	"github.com/trustwallet/go-primitives/coin"
 )
 
-// nolint:cyclop
+// GetChainFromAssetType returns the coin of the token type, defined by the token types of coins.yml.
 func GetChainFromAssetType(assetType string) (coin.Coin, error) {
-	switch TokenType(assetType) {
-	case BRC20:
-		return coin.Bitcoin(), nil
-	case ERC20:
-		return coin.Ethereum(), nil
-	case BEP2, BEP8:
-		return coin.Binance(), nil
-	case BEP20:
-		return coin.Smartchain(), nil
-	case ETC20:
-		return coin.Classic(), nil
-	case TRC10, TRC20:
-		return coin.Tron(), nil
-	case WAN20:
-		return coin.Wanchain(), nil
-	case TT20:
-		return coin.Thundertoken(), nil
-	case SPL:
-		return coin.Solana(), nil
-	case EOS:
-		return coin.Eos(), nil
-	case GO20:
-		return coin.Gochain(), nil
-	case KAVA:
-		return coin.Kava(), nil
-	case NEP5:
-		return coin.Neo(), nil
-	case NRC20:
-		return coin.Nuls(), nil
-	case VET:
-		return coin.Vechain(), nil
-	case ONTOLOGY:
-		return coin.Ontology(), nil
-	case THETA:
-		return coin.Theta(), nil
-	case TOMO, TRC21:
-		return coin.Tomochain(), nil
-	case XDAI:
-		return coin.Xdai(), nil
-	case WAVES:
-		return coin.Waves(), nil
-	case POA, POA20:
-		return coin.Poa(), nil
-	case POLYGON:
-		return coin.Polygon(), nil
-	case OPTIMISM:
-		return coin.Optimism(), nil
-	case AVALANCHE:
-		return coin.Avalanchec(), nil
-	case ARBITRUM:
-		return coin.Arbitrum(), nil
-	case FANTOM:
-		return coin.Fantom(), nil
-	case TERRA, CW20:
-		return coin.Terra(), nil
-	case RONIN:
-		return coin.Ronin(), nil
-	case CELO:
-		return coin.Celo(), nil
-	case HRC20:
-		return coin.Heco(), nil
-	case CLO20:
-		return coin.Callisto(), nil
-	case ESDT:
-		return coin.Elrond(), nil
-	case OASIS:
-		return coin.Oasis(), nil
-	case CRC20:
-		return coin.Cronos(), nil
-	case STELLAR:
-		return coin.Stellar(), nil
-	case KRC20:
-		return coin.Kcc(), nil
-	case AURORA:
-		return coin.Aurora(), nil
-	case ALGORAND:
-		return coin.Algorand(), nil
-	case KAVAEVM:
-		return coin.Kavaevm(), nil
-	case METER:
-		return coin.Meter(), nil
-	case EVMOS_ERC20:
-		return coin.Evmos(), nil
-	case KIP20:
-		return coin.Okc(), nil
-	case APTOS, APTOSFA:
-		return coin.Aptos(), nil
-	case MOONBEAM:
-		return coin.Moonbeam(), nil
-	case KLAYTN:
-		return coin.Klaytn(), nil
-	case METIS:
-		return coin.Metis(), nil
-	case MOONRIVER:
-		return coin.Moonriver(), nil
-	case BOBA:
-		return coin.Boba(), nil
-	case JETTON:
-		return coin.Ton(), nil
-	case POLYGONZKEVM:
-		return coin.Polygonzkevm(), nil
-	case ZKSYNC:
-		return coin.Zksync(), nil
-	case SUI:
-		return coin.Sui(), nil
-	case STRIDE:
-		return coin.Stride(), nil
-	case NEUTRON:
-		return coin.Neutron(), nil
-	case FA2:
-		return coin.Tezos(), nil
-	case CONFLUX:
-		return coin.Cfxevm(), nil
-	case ACA:
-		return coin.Acala(), nil
-	case ACALAEVM:
-		return coin.Acalaevm(), nil
-	case BASE:
-		return coin.Base(), nil
-	case AKASH:
-		return coin.Akash(), nil
-	case AGORIC:
-		return coin.Agoric(), nil
-	case AXELAR:
-		return coin.Axelar(), nil
-	case JUNO:
-		return coin.Juno(), nil
-	case SEI:
-		return coin.Sei(), nil
-	case CARDANO:
-		return coin.Cardano(), nil
-	case NEON:
-		return coin.Neon(), nil
-	case OSMOSIS:
-		return coin.Osmosis(), nil
-	case NATIVEINJECTIVE:
-		return coin.Nativeinjective(), nil
-	case NATIVEEVMOS:
-		return coin.Nativeevmos(), nil
-	case CRYPTOORG:
-		return coin.Cryptoorg(), nil
-	case COSMOS:
-		return coin.Cosmos(), nil
-	case OPBNB:
-		return coin.Opbnb(), nil
-	case LINEA:
-		return coin.Linea(), nil
-	case STARGAZE:
-		return coin.Stargaze(), nil
-	case MANTLE:
-		return coin.Mantle(), nil
-	case MANTA:
-		return coin.Manta(), nil
-	case ZETACHAIN:
-		return coin.Zetachain(), nil
-	case ZETAEVM:
-		return coin.Zetaevm(), nil
-	case MERLIN:
-		return coin.Merlin(), nil
-	case BLAST:
-		return coin.Blast(), nil
-	case SCROLL:
-		return coin.Scroll(), nil
-	case ICP:
-		return coin.Internet_computer(), nil
-	case BOUNCEBIT:
-		return coin.Bouncebit(), nil
-	case ZKLINKNOVA:
-		return coin.Zklinknova(), nil
-	case XRP:
-		return coin.Ripple(), nil
-	case SONIC:
-		return coin.Sonic(), nil
-	case TIA:
-		return coin.Tia(), nil
-	case DYDX:
-		return coin.Dydx(), nil
-	case PLASMA:
-		return coin.Plasma(), nil
-	case MONAD:
-		return coin.Monad(), nil
-	case MEGAETH:
-		return coin.Megaeth(), nil
-	case SEIEVM:
-		return coin.Seievm(), nil
-	case HYPEREVM:
-		return coin.Hyperevm(), nil
-	case ROBINHOODCHAIN:
-		return coin.Robinhoodchain(), nil
+	if id, ok := tokenTypeCoins[TokenType(assetType)]; ok {
+		return coin.Coins[id], nil
 	}
-
 	return coin.Coin{}, errors.New("unknown asset type: " + assetType)
 }
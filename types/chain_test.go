@@ -130,6 +130,22 @@ func TestGetChainFromAssetType(t *testing.T) {
 			want:    coin.Hyperevm(),
 			wantErr: false,
 		},
+		{
+			name: "Test IoTeX EVM XRC20",
+			args: args{
+				type_: "XRC20",
+			},
+			want:    coin.Iotexevm(),
+			wantErr: false,
+		},
+		{
+			name: "Test Harmony shares HRC20 of Heco",
+			args: args{
+				type_: "HRC20",
+			},
+			want:    coin.Heco(),
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1,22 +1,7 @@
 package types
 
-const (
-	ChainIDEthereum   = 1
-	ChainIDOptimism   = 10
-	ChainIDSmartChain = 56
-	ChainIDPolygon    = 137
-	ChainIDArbitrum   = 42161
-	ChainIDGnosis     = 100
-	ChainIDAvalanche  = 43114
-	ChainIDFantom     = 250
-	ChainIDMoonbeam   = 1284
-	ChainIDKlaytn     = 8217
-	ChainIDMetis      = 1088
-	ChainIDMoonriver  = 1285
-	ChainIDBoba       = 288
-	ChainIDTon        = 607
-	ChainIDZKEVM      = 1101
-	ChainIDZKSync     = 324
-	ChainIDIoTeXEVM   = 4689
-	ChainIDCFXEVM     = 1030
-)
+import "github.com/trustwallet/go-primitives/coin"
+
+// ChainIDTon is the coin type of TON, which has no EIP155 chain ID.
+// The chain IDs of EVM coins are generated from coins.yml into tokentypes.go.
+const ChainIDTon = coin.TON
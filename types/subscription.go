@@ -2,6 +2,8 @@ package types
 
 import (
 	"strconv"
+
+	"github.com/trustwallet/go-primitives/address"
 )
 
 type (
@@ -37,8 +39,16 @@ func (v *Subscription) AddressID() string {
 	return GetAddressID(strconv.Itoa(int(v.Coin)), v.Address)
 }
 
-func GetAddressID(coin, address string) string {
-	return coin + "_" + address
+// GetAddressID returns the "<coin>_<address>" ID of an address. Valid addresses use the form
+// of address.Normalize, so every spelling of an address has the same ID. Other values,
+// e.g. extended public keys, are kept as given.
+func GetAddressID(coin, addr string) string {
+	if coinID, err := strconv.ParseUint(coin, 10, 32); err == nil {
+		if normalized, err := address.Normalize(uint(coinID), addr); err == nil {
+			addr = normalized
+		}
+	}
+	return coin + "_" + addr
 }
 
 func (e *SubscriptionEvent) ParseSubscriptions(s Subscriptions) []Subscription {
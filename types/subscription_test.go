@@ -68,3 +68,29 @@ func Test_parseSubscriptions(t *testing.T) {
 		})
 	}
 }
+
+func TestGetAddressID(t *testing.T) {
+	tests := []struct {
+		name    string
+		coin    string
+		address string
+		want    string
+	}{
+		{"checksummed", "60", "0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", "60_0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb"},
+		{"lower case", "60", "0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb", "60_0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb"},
+		{"ronin prefix", "10002020", "ronin:ab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb", "10002020_0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb"},
+		{"bech32 upper case", "118", "COSMOS1HSK6JRYYQJFHP5DHC55TC9JTCKYGX0EPH6DD02", "118_cosmos1hsk6jryyqjfhp5dhc55tc9jtckygx0eph6dd02"},
+		{"xpub kept", "0", "xpub6BpYi6J1GZzfY3yY7DbhLLccF3efQa18nQngM3jaehgtNSoEgk6UtPULpC3oK5oA3trczY8Ld34LFw1USMPfGHwTEizdD5QyGcMyuh2UoBA", "0_xpub6BpYi6J1GZzfY3yY7DbhLLccF3efQa18nQngM3jaehgtNSoEgk6UtPULpC3oK5oA3trczY8Ld34LFw1USMPfGHwTEizdD5QyGcMyuh2UoBA"},
+		{"invalid kept", "60", "0xab16", "60_0xab16"},
+		{"unknown coin kept", "123456", "abc", "123456_abc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, GetAddressID(tt.coin, tt.address))
+		})
+	}
+
+	sub := Subscription{Coin: 60, Address: "0xab16a96d359ec26a11e2c2b3d8f8b8942d5bfcdb"}
+	assert.Equal(t, "60_0xab16a96D359eC26a11e2C2b3d8f8B8942d5Bfcdb", sub.AddressID())
+}
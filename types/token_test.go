@@ -185,6 +185,36 @@ func TestGetEthereumTokenTypeByIndex(t *testing.T) {
 			args: args{coinIndex: coin.ROBINHOODCHAIN},
 			want: ROBINHOODCHAIN,
 		},
+		{
+			name: "IoTeX EVM XRC20",
+			args: args{coinIndex: coin.IOTEXEVM},
+			want: IOTEXEVM,
+		},
+		{
+			name: "TON JETTON",
+			args: args{coinIndex: coin.TON},
+			want: JETTON,
+		},
+		{
+			name: "Sui SUI",
+			args: args{coinIndex: coin.SUI},
+			want: SUI,
+		},
+		{
+			name: "Stride STRIDE",
+			args: args{coinIndex: coin.STRIDE},
+			want: STRIDE,
+		},
+		{
+			name: "Neutron NEUTRON",
+			args: args{coinIndex: coin.NEUTRON},
+			want: NEUTRON,
+		},
+		{
+			name:    "Tron is not EVM",
+			args:    args{coinIndex: coin.TRON},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -467,6 +497,24 @@ func TestGetTokenType(t *testing.T) {
 			want:     string(ROBINHOODCHAIN),
 			wantBool: true,
 		},
+		{
+			name:     "Harmony",
+			args:     args{coin.HARMONY, ""},
+			want:     string(HRC20),
+			wantBool: true,
+		},
+		{
+			name:     "Tezos",
+			args:     args{coin.TEZOS, "KT1XnTn74bUtxHfDtBmm2bGZAQfhPbvKWR8o"},
+			want:     "",
+			wantBool: false,
+		},
+		{
+			name:     "Bitcoin Cash without tokens",
+			args:     args{coin.BITCOINCASH, ""},
+			want:     "",
+			wantBool: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -735,6 +783,24 @@ func TestGetTokenVersion(t *testing.T) {
 			TokenVersionV24,
 			nil,
 		},
+		{
+			"IoTeX EVM token version",
+			args{t: string(IOTEXEVM)},
+			TokenVersionV14,
+			nil,
+		},
+		{
+			"ERC721 token version",
+			args{t: string(ERC721)},
+			TokenVersionUndefined,
+			nil,
+		},
+		{
+			"Unknown token version",
+			args{t: "UNKNOWN20"},
+			TokenVersionUndefined,
+			ErrUnknownTokenType,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -745,6 +811,28 @@ func TestGetTokenVersion(t *testing.T) {
 	}
 }
 
+// TestGetTokenTypes pins the supported token types and their order. XRC20 of IoTeX EVM is the only addition
+// to the hand written list: GetTokenType detects it, so GetTokenVersion and ParseTokenTypeFromString must accept it.
+func TestGetTokenTypes(t *testing.T) {
+	assert.Equal(t, []TokenType{
+		BRC20, ERC20, ERC721, ERC1155, BEP2, BEP8, BEP20, TRC10, ETC20, POA20, TRC20, TRC21, CLO20, GO20, WAN20, TT20,
+		CW20, COSMOS, KAVA, JUNO, AGORIC, AKASH, AXELAR, CRYPTOORG, NATIVEEVMOS, NATIVEINJECTIVE, OSMOSIS, STARGAZE, SPL,
+		POLYGON, OPTIMISM, XDAI, AVALANCHE, FANTOM, HRC20, ARBITRUM, TERRA, RONIN, EOS, NEP5, NRC20, VET, ONTOLOGY,
+		THETA, TOMO, WAVES, POA, CELO, ESDT, OASIS, CRC20, STELLAR, KRC20, AURORA, ALGORAND, KAVAEVM, METER,
+		EVMOS_ERC20, KIP20, APTOS, APTOSFA, MOONBEAM, KLAYTN, METIS, MOONRIVER, BOBA, JETTON, POLYGONZKEVM, ZKSYNC,
+		SUI, STRIDE, NEUTRON, CONFLUX, ACA, BASE, SEI, SEIEVM, CARDANO, NEON, OPBNB, LINEA, ACALAEVM, MANTLE, MANTA,
+		ZETACHAIN, ZETAEVM, MERLIN, BLAST, SCROLL, ICP, BOUNCEBIT, ZKLINKNOVA, XRP, SONIC, TIA, DYDX, PLASMA, MONAD,
+		MEGAETH, HYPEREVM, ROBINHOODCHAIN,
+		IOTEXEVM,
+	}, GetTokenTypes())
+
+	tokenType, ok := GetTokenType(coin.IOTEXEVM, "0x6fb3e0a217407efff7ca062d46c26e5d60a14d69")
+	assert.True(t, ok)
+	parsed, err := ParseTokenTypeFromString(tokenType)
+	assert.NoError(t, err)
+	assert.Equal(t, IOTEXEVM, parsed)
+}
+
 // TestGetTokenVersionImplementEverySupportedTokenTypes makes sure every supported token type has a version.
 // This also makes sure when we add new token type, we remember to add a version for it
 func TestGetTokenVersionImplementEverySupportedTokenTypes(t *testing.T) {
@@ -772,3 +860,49 @@ func TestGetCheckTokenTypes(t *testing.T) {
 		assert.Truef(t, len(result) > 0, "Empty token type for coin %d", c.ID)
 	}
 }
+
+// TestCoinTokenTypesAreSupported makes sure every token type detected by GetTokenType is listed by GetTokenTypes
+// and belongs to a coin.
+func TestCoinTokenTypesAreSupported(t *testing.T) {
+	for coinID, rules := range coinTokenTypes {
+		for _, rule := range rules {
+			_, err := ParseTokenTypeFromString(string(rule.Type))
+			assert.NoError(t, err)
+
+			_, err = GetChainFromAssetType(string(rule.Type))
+			assert.NoError(t, err, "coin %d", coinID)
+		}
+	}
+}
+
+func TestTokenTypeRule(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    tokenTypeRule
+		tokenID string
+		want    bool
+	}{
+		{"no condition", tokenTypeRule{Type: TRC20}, "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", true},
+		{"numeric", tokenTypeRule{Type: TRC10, Numeric: true}, "1002000", true},
+		{"not numeric", tokenTypeRule{Type: TRC10, Numeric: true}, "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t", false},
+		{"length", tokenTypeRule{Type: CW20, Length: 44}, "terra14z56l0fp2lsf86zy3hty2z47ezkhnthtr9yq76", true},
+		{"other length", tokenTypeRule{Type: CW20, Length: 44}, "uluna", false},
+		{"contains", tokenTypeRule{Type: APTOS, Contains: "::"}, "0x1::aptos_coin::AptosCoin", true},
+		{"does not contain", tokenTypeRule{Type: APTOS, Contains: "::"}, "0x357b", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.rule.matches(tt.tokenID))
+		})
+	}
+}
+
+func TestChainIDs(t *testing.T) {
+	assert.Equal(t, 1, ChainIDEthereum)
+	assert.Equal(t, 56, ChainIDSmartChain)
+	assert.Equal(t, 100, ChainIDGnosis)
+	assert.Equal(t, 4689, ChainIDIoTeXEVM)
+	assert.Equal(t, 8453, ChainIDBase)
+	assert.Equal(t, 607, ChainIDTon)
+}
@@ -0,0 +1,840 @@
+// Code generated by go generate; DO NOT EDIT.
+// This file was generated by robots using data from coins.yml
+package types
+
+import (
+	"github.com/trustwallet/go-primitives/coin"
+)
+
+const (
+	ERC20           TokenType = "ERC20"
+	ERC721          TokenType = "ERC721"
+	ERC1155         TokenType = "ERC1155"
+	ETC20           TokenType = "ETC20"
+	COSMOS          TokenType = "COSMOS"
+	XRP             TokenType = "XRP"
+	STELLAR         TokenType = "STELLAR"
+	POA20           TokenType = "POA20"
+	POA             TokenType = "POA"
+	TRC10           TokenType = "TRC10"
+	TRC20           TokenType = "TRC20"
+	IOTEXEVM        TokenType = "XRC20"
+	KAVA            TokenType = "KAVA"
+	THETA           TokenType = "THETA"
+	BEP2            TokenType = "BEP2"
+	BEP8            TokenType = "BEP8"
+	VET             TokenType = "VET"
+	CLO20           TokenType = "CLO20"
+	TRC21           TokenType = "TRC21"
+	TOMO            TokenType = "TOMO"
+	TT20            TokenType = "TT20"
+	ONTOLOGY        TokenType = "ONTOLOGY"
+	FA2             TokenType = "FA2"
+	GO20            TokenType = "GO20"
+	WAN20           TokenType = "WAN20"
+	WAVES           TokenType = "WAVES"
+	BRC20           TokenType = "BRC20"
+	ALGORAND        TokenType = "ASA"
+	SPL             TokenType = "SPL"
+	ESDT            TokenType = "ESDT"
+	BEP20           TokenType = "BEP20"
+	OASIS           TokenType = "OASIS"
+	EOS             TokenType = "EOS"
+	CW20            TokenType = "CW20"
+	TERRA           TokenType = "TERRA"
+	NEP5            TokenType = "NEP5"
+	CARDANO         TokenType = "CARDANO"
+	NRC20           TokenType = "NRC20"
+	POLYGON         TokenType = "POLYGON"
+	OPTIMISM        TokenType = "OPTIMISM"
+	XDAI            TokenType = "XDAI"
+	AVALANCHE       TokenType = "AVALANCHE"
+	HRC20           TokenType = "HRC20"
+	FANTOM          TokenType = "FANTOM"
+	ARBITRUM        TokenType = "ARBITRUM"
+	CELO            TokenType = "CELO"
+	RONIN           TokenType = "RONIN"
+	OSMOSIS         TokenType = "OSMOSIS"
+	CRC20           TokenType = "CRC20"
+	KRC20           TokenType = "KRC20"
+	AURORA          TokenType = "AURORA"
+	KAVAEVM         TokenType = "KAVAEVM"
+	METER           TokenType = "METER"
+	EVMOS_ERC20     TokenType = "EVMOS_ERC20"
+	NATIVEEVMOS     TokenType = "NATIVEEVMOS"
+	KIP20           TokenType = "KIP20"
+	CRYPTOORG       TokenType = "CRYPTOORG"
+	APTOS           TokenType = "APTOS"
+	APTOSFA         TokenType = "APTOSFA"
+	MEGAETH         TokenType = "MEGAETH"
+	MOONBEAM        TokenType = "MOONBEAM"
+	KLAYTN          TokenType = "KAIA"
+	METIS           TokenType = "METIS"
+	MOONRIVER       TokenType = "MOONRIVER"
+	BOBA            TokenType = "BOBA"
+	JETTON          TokenType = "JETTON"
+	POLYGONZKEVM    TokenType = "ZKEVM"
+	ZKSYNC          TokenType = "ZKSYNC"
+	SUI             TokenType = "SUI"
+	STRIDE          TokenType = "STRIDE"
+	NEUTRON         TokenType = "NEUTRON"
+	STARGAZE        TokenType = "STARGAZE"
+	NATIVEINJECTIVE TokenType = "INJECTIVE"
+	CONFLUX         TokenType = "CONFLUX"
+	ACA             TokenType = "ACA"
+	ACALAEVM        TokenType = "ACALAEVM"
+	BASE            TokenType = "BASE"
+	AKASH           TokenType = "AKT"
+	AGORIC          TokenType = "BLD"
+	AXELAR          TokenType = "AXL"
+	JUNO            TokenType = "JUNO"
+	SEI             TokenType = "SEI"
+	SEIEVM          TokenType = "SEIEVM"
+	NEON            TokenType = "NEON"
+	OPBNB           TokenType = "OPBNB"
+	LINEA           TokenType = "LINEA"
+	MANTLE          TokenType = "MANTLE"
+	MANTA           TokenType = "MANTA"
+	ZETACHAIN       TokenType = "ZETACHAIN"
+	ZETAEVM         TokenType = "ZETAEVM"
+	MERLIN          TokenType = "MERLIN"
+	BLAST           TokenType = "BLAST"
+	SCROLL          TokenType = "SCROLL"
+	ICP             TokenType = "ICP"
+	BOUNCEBIT       TokenType = "BOUNCEBIT"
+	ZKLINKNOVA      TokenType = "ZKLINKNOVA"
+	SONIC           TokenType = "SONIC"
+	TIA             TokenType = "TIA"
+	DYDX            TokenType = "DYDX"
+	PLASMA          TokenType = "PLASMA"
+	MONAD           TokenType = "MONAD"
+	HYPEREVM        TokenType = "HYPEREVM"
+	ROBINHOODCHAIN  TokenType = "ROBINHOODCHAIN"
+)
+
+// EIP155 chain IDs of the EVM coins
+const (
+	ChainIDEthereum       = 1
+	ChainIDClassic        = 61
+	ChainIDPoa            = 99
+	ChainIDIoTeXEVM       = 4689
+	ChainIDCallisto       = 820
+	ChainIDTomochain      = 88
+	ChainIDThundertoken   = 108
+	ChainIDGochain        = 60
+	ChainIDWanchain       = 888
+	ChainIDSmartChain     = 56
+	ChainIDPolygon        = 137
+	ChainIDOptimism       = 10
+	ChainIDGnosis         = 100
+	ChainIDAvalanche      = 43114
+	ChainIDHeco           = 128
+	ChainIDFantom         = 250
+	ChainIDArbitrum       = 42161
+	ChainIDCelo           = 42220
+	ChainIDRonin          = 2020
+	ChainIDCronos         = 25
+	ChainIDKcc            = 321
+	ChainIDAurora         = 1313161554
+	ChainIDKavaevm        = 2222
+	ChainIDMeter          = 82
+	ChainIDEvmos          = 9001
+	ChainIDOkc            = 66
+	ChainIDMegaeth        = 4326
+	ChainIDMoonbeam       = 1284
+	ChainIDKlaytn         = 8217
+	ChainIDMetis          = 1088
+	ChainIDMoonriver      = 1285
+	ChainIDBoba           = 288
+	ChainIDZKEVM          = 1101
+	ChainIDZKSync         = 324
+	ChainIDCFXEVM         = 1030
+	ChainIDAcalaevm       = 787
+	ChainIDBase           = 8453
+	ChainIDSeievm         = 1329
+	ChainIDNeon           = 245022934
+	ChainIDOpbnb          = 204
+	ChainIDLinea          = 59144
+	ChainIDMantle         = 5000
+	ChainIDManta          = 169
+	ChainIDZetaevm        = 7000
+	ChainIDMerlin         = 4200
+	ChainIDBlast          = 81457
+	ChainIDScroll         = 534352
+	ChainIDBouncebit      = 6001
+	ChainIDZklinknova     = 810180
+	ChainIDSonic          = 146
+	ChainIDPlasma         = 9745
+	ChainIDMonad          = 143
+	ChainIDHyperevm       = 999
+	ChainIDRobinhoodchain = 4663
+	ChainIDRootstock      = 30
+)
+
+var tokenTypes = []TokenType{
+	BRC20,
+	ERC20,
+	ERC721,
+	ERC1155,
+	BEP2,
+	BEP8,
+	BEP20,
+	TRC10,
+	ETC20,
+	POA20,
+	TRC20,
+	TRC21,
+	CLO20,
+	GO20,
+	WAN20,
+	TT20,
+	CW20,
+	COSMOS,
+	KAVA,
+	JUNO,
+	AGORIC,
+	AKASH,
+	AXELAR,
+	CRYPTOORG,
+	NATIVEEVMOS,
+	NATIVEINJECTIVE,
+	OSMOSIS,
+	STARGAZE,
+	SPL,
+	POLYGON,
+	OPTIMISM,
+	XDAI,
+	AVALANCHE,
+	FANTOM,
+	HRC20,
+	ARBITRUM,
+	TERRA,
+	RONIN,
+	EOS,
+	NEP5,
+	NRC20,
+	VET,
+	ONTOLOGY,
+	THETA,
+	TOMO,
+	WAVES,
+	POA,
+	CELO,
+	ESDT,
+	OASIS,
+	CRC20,
+	STELLAR,
+	KRC20,
+	AURORA,
+	ALGORAND,
+	KAVAEVM,
+	METER,
+	EVMOS_ERC20,
+	KIP20,
+	APTOS,
+	APTOSFA,
+	MOONBEAM,
+	KLAYTN,
+	METIS,
+	MOONRIVER,
+	BOBA,
+	JETTON,
+	POLYGONZKEVM,
+	ZKSYNC,
+	SUI,
+	STRIDE,
+	NEUTRON,
+	CONFLUX,
+	ACA,
+	BASE,
+	SEI,
+	SEIEVM,
+	CARDANO,
+	NEON,
+	OPBNB,
+	LINEA,
+	ACALAEVM,
+	MANTLE,
+	MANTA,
+	ZETACHAIN,
+	ZETAEVM,
+	MERLIN,
+	BLAST,
+	SCROLL,
+	ICP,
+	BOUNCEBIT,
+	ZKLINKNOVA,
+	XRP,
+	SONIC,
+	TIA,
+	DYDX,
+	PLASMA,
+	MONAD,
+	MEGAETH,
+	HYPEREVM,
+	ROBINHOODCHAIN,
+	IOTEXEVM,
+}
+
+var tokenVersions = map[TokenType]TokenVersion{
+	ERC20:           TokenVersionV0,
+	ERC721:          TokenVersionUndefined,
+	ERC1155:         TokenVersionUndefined,
+	ETC20:           TokenVersionV0,
+	COSMOS:          TokenVersionUndefined,
+	XRP:             TokenVersionV22,
+	STELLAR:         TokenVersionV10,
+	POA20:           TokenVersionV0,
+	POA:             TokenVersionUndefined,
+	TRC10:           TokenVersionV0,
+	TRC20:           TokenVersionV1,
+	IOTEXEVM:        TokenVersionV14,
+	KAVA:            TokenVersionV3,
+	THETA:           TokenVersionUndefined,
+	BEP2:            TokenVersionV0,
+	BEP8:            TokenVersionV0,
+	VET:             TokenVersionUndefined,
+	CLO20:           TokenVersionV0,
+	TRC21:           TokenVersionV0,
+	TOMO:            TokenVersionUndefined,
+	TT20:            TokenVersionV0,
+	ONTOLOGY:        TokenVersionUndefined,
+	FA2:             TokenVersionUndefined,
+	GO20:            TokenVersionV0,
+	WAN20:           TokenVersionV0,
+	WAVES:           TokenVersionV0,
+	BRC20:           TokenVersionV16,
+	ALGORAND:        TokenVersionUndefined,
+	SPL:             TokenVersionV3,
+	ESDT:            TokenVersionV9,
+	BEP20:           TokenVersionV0,
+	OASIS:           TokenVersionUndefined,
+	EOS:             TokenVersionUndefined,
+	CW20:            TokenVersionV8,
+	TERRA:           TokenVersionV6,
+	NEP5:            TokenVersionUndefined,
+	CARDANO:         TokenVersionUndefined,
+	NRC20:           TokenVersionV7,
+	POLYGON:         TokenVersionV4,
+	OPTIMISM:        TokenVersionV5,
+	XDAI:            TokenVersionV5,
+	AVALANCHE:       TokenVersionV5,
+	HRC20:           TokenVersionV5,
+	FANTOM:          TokenVersionV5,
+	ARBITRUM:        TokenVersionV5,
+	CELO:            TokenVersionV7,
+	RONIN:           TokenVersionV11,
+	OSMOSIS:         TokenVersionUndefined,
+	CRC20:           TokenVersionV9,
+	KRC20:           TokenVersionV10,
+	AURORA:          TokenVersionV11,
+	KAVAEVM:         TokenVersionV14,
+	METER:           TokenVersionUndefined,
+	EVMOS_ERC20:     TokenVersionUndefined,
+	NATIVEEVMOS:     TokenVersionUndefined,
+	KIP20:           TokenVersionUndefined,
+	CRYPTOORG:       TokenVersionUndefined,
+	APTOS:           TokenVersionV0,
+	APTOSFA:         TokenVersionV21,
+	MEGAETH:         TokenVersionV27,
+	MOONBEAM:        TokenVersionV14,
+	KLAYTN:          TokenVersionV14,
+	METIS:           TokenVersionV14,
+	MOONRIVER:       TokenVersionV14,
+	BOBA:            TokenVersionV14,
+	JETTON:          TokenVersionV12,
+	POLYGONZKEVM:    TokenVersionV12,
+	ZKSYNC:          TokenVersionV12,
+	SUI:             TokenVersionV12,
+	STRIDE:          TokenVersionUndefined,
+	NEUTRON:         TokenVersionUndefined,
+	STARGAZE:        TokenVersionUndefined,
+	NATIVEINJECTIVE: TokenVersionV14,
+	CONFLUX:         TokenVersionV14,
+	ACA:             TokenVersionV14,
+	ACALAEVM:        TokenVersionV14,
+	BASE:            TokenVersionV13,
+	AKASH:           TokenVersionV13,
+	AGORIC:          TokenVersionV13,
+	AXELAR:          TokenVersionV13,
+	JUNO:            TokenVersionV13,
+	SEI:             TokenVersionV13,
+	SEIEVM:          TokenVersionUndefined,
+	NEON:            TokenVersionV14,
+	OPBNB:           TokenVersionV13,
+	LINEA:           TokenVersionV14,
+	MANTLE:          TokenVersionV14,
+	MANTA:           TokenVersionV14,
+	ZETACHAIN:       TokenVersionV14,
+	ZETAEVM:         TokenVersionV14,
+	MERLIN:          TokenVersionV17,
+	BLAST:           TokenVersionV18,
+	SCROLL:          TokenVersionV18,
+	ICP:             TokenVersionV17,
+	BOUNCEBIT:       TokenVersionV19,
+	ZKLINKNOVA:      TokenVersionV20,
+	SONIC:           TokenVersionV22,
+	TIA:             TokenVersionUndefined,
+	DYDX:            TokenVersionUndefined,
+	PLASMA:          TokenVersionV23,
+	MONAD:           TokenVersionV26,
+	HYPEREVM:        TokenVersionV28,
+	ROBINHOODCHAIN:  TokenVersionV24,
+}
+
+var tokenTypeCoins = map[TokenType]uint{
+	ERC20:           coin.ETHEREUM,
+	ETC20:           coin.CLASSIC,
+	COSMOS:          coin.COSMOS,
+	XRP:             coin.RIPPLE,
+	STELLAR:         coin.STELLAR,
+	POA20:           coin.POA,
+	POA:             coin.POA,
+	TRC10:           coin.TRON,
+	TRC20:           coin.TRON,
+	IOTEXEVM:        coin.IOTEXEVM,
+	KAVA:            coin.KAVA,
+	THETA:           coin.THETA,
+	BEP2:            coin.BINANCE,
+	BEP8:            coin.BINANCE,
+	VET:             coin.VECHAIN,
+	CLO20:           coin.CALLISTO,
+	TRC21:           coin.TOMOCHAIN,
+	TOMO:            coin.TOMOCHAIN,
+	TT20:            coin.THUNDERTOKEN,
+	ONTOLOGY:        coin.ONTOLOGY,
+	FA2:             coin.TEZOS,
+	GO20:            coin.GOCHAIN,
+	WAN20:           coin.WANCHAIN,
+	WAVES:           coin.WAVES,
+	BRC20:           coin.BITCOIN,
+	ALGORAND:        coin.ALGORAND,
+	SPL:             coin.SOLANA,
+	ESDT:            coin.ELROND,
+	BEP20:           coin.SMARTCHAIN,
+	OASIS:           coin.OASIS,
+	EOS:             coin.EOS,
+	CW20:            coin.TERRA,
+	TERRA:           coin.TERRA,
+	NEP5:            coin.NEO,
+	CARDANO:         coin.CARDANO,
+	NRC20:           coin.NULS,
+	POLYGON:         coin.POLYGON,
+	OPTIMISM:        coin.OPTIMISM,
+	XDAI:            coin.XDAI,
+	AVALANCHE:       coin.AVALANCHEC,
+	HRC20:           coin.HECO,
+	FANTOM:          coin.FANTOM,
+	ARBITRUM:        coin.ARBITRUM,
+	CELO:            coin.CELO,
+	RONIN:           coin.RONIN,
+	OSMOSIS:         coin.OSMOSIS,
+	CRC20:           coin.CRONOS,
+	KRC20:           coin.KCC,
+	AURORA:          coin.AURORA,
+	KAVAEVM:         coin.KAVAEVM,
+	METER:           coin.METER,
+	EVMOS_ERC20:     coin.EVMOS,
+	NATIVEEVMOS:     coin.NATIVEEVMOS,
+	KIP20:           coin.OKC,
+	CRYPTOORG:       coin.CRYPTOORG,
+	APTOS:           coin.APTOS,
+	APTOSFA:         coin.APTOS,
+	MEGAETH:         coin.MEGAETH,
+	MOONBEAM:        coin.MOONBEAM,
+	KLAYTN:          coin.KLAYTN,
+	METIS:           coin.METIS,
+	MOONRIVER:       coin.MOONRIVER,
+	BOBA:            coin.BOBA,
+	JETTON:          coin.TON,
+	POLYGONZKEVM:    coin.POLYGONZKEVM,
+	ZKSYNC:          coin.ZKSYNC,
+	SUI:             coin.SUI,
+	STRIDE:          coin.STRIDE,
+	NEUTRON:         coin.NEUTRON,
+	STARGAZE:        coin.STARGAZE,
+	NATIVEINJECTIVE: coin.NATIVEINJECTIVE,
+	CONFLUX:         coin.CFXEVM,
+	ACA:             coin.ACALA,
+	ACALAEVM:        coin.ACALAEVM,
+	BASE:            coin.BASE,
+	AKASH:           coin.AKASH,
+	AGORIC:          coin.AGORIC,
+	AXELAR:          coin.AXELAR,
+	JUNO:            coin.JUNO,
+	SEI:             coin.SEI,
+	SEIEVM:          coin.SEIEVM,
+	NEON:            coin.NEON,
+	OPBNB:           coin.OPBNB,
+	LINEA:           coin.LINEA,
+	MANTLE:          coin.MANTLE,
+	MANTA:           coin.MANTA,
+	ZETACHAIN:       coin.ZETACHAIN,
+	ZETAEVM:         coin.ZETAEVM,
+	MERLIN:          coin.MERLIN,
+	BLAST:           coin.BLAST,
+	SCROLL:          coin.SCROLL,
+	ICP:             coin.INTERNET_COMPUTER,
+	BOUNCEBIT:       coin.BOUNCEBIT,
+	ZKLINKNOVA:      coin.ZKLINKNOVA,
+	SONIC:           coin.SONIC,
+	TIA:             coin.TIA,
+	DYDX:            coin.DYDX,
+	PLASMA:          coin.PLASMA,
+	MONAD:           coin.MONAD,
+	HYPEREVM:        coin.HYPEREVM,
+	ROBINHOODCHAIN:  coin.ROBINHOODCHAIN,
+}
+
+// indexTokenTypes holds the token type GetEthereumTokenTypeByIndex returns for a coin
+var indexTokenTypes = map[uint]TokenType{
+	coin.ETHEREUM:       ERC20,
+	coin.CLASSIC:        ETC20,
+	coin.POA:            POA20,
+	coin.IOTEXEVM:       IOTEXEVM,
+	coin.CALLISTO:       CLO20,
+	coin.TOMOCHAIN:      TRC21,
+	coin.THUNDERTOKEN:   TT20,
+	coin.GOCHAIN:        GO20,
+	coin.WANCHAIN:       WAN20,
+	coin.SMARTCHAIN:     BEP20,
+	coin.POLYGON:        POLYGON,
+	coin.OPTIMISM:       OPTIMISM,
+	coin.XDAI:           XDAI,
+	coin.AVALANCHEC:     AVALANCHE,
+	coin.HECO:           HRC20,
+	coin.FANTOM:         FANTOM,
+	coin.ARBITRUM:       ARBITRUM,
+	coin.CELO:           CELO,
+	coin.RONIN:          RONIN,
+	coin.CRONOS:         CRC20,
+	coin.KCC:            KRC20,
+	coin.AURORA:         AURORA,
+	coin.KAVAEVM:        KAVAEVM,
+	coin.METER:          METER,
+	coin.EVMOS:          EVMOS_ERC20,
+	coin.OKC:            KIP20,
+	coin.MEGAETH:        MEGAETH,
+	coin.MOONBEAM:       MOONBEAM,
+	coin.KLAYTN:         KLAYTN,
+	coin.METIS:          METIS,
+	coin.MOONRIVER:      MOONRIVER,
+	coin.BOBA:           BOBA,
+	coin.TON:            JETTON,
+	coin.POLYGONZKEVM:   POLYGONZKEVM,
+	coin.ZKSYNC:         ZKSYNC,
+	coin.SUI:            SUI,
+	coin.STRIDE:         STRIDE,
+	coin.NEUTRON:        NEUTRON,
+	coin.CFXEVM:         CONFLUX,
+	coin.ACALAEVM:       ACALAEVM,
+	coin.BASE:           BASE,
+	coin.SEIEVM:         SEIEVM,
+	coin.NEON:           NEON,
+	coin.OPBNB:          OPBNB,
+	coin.LINEA:          LINEA,
+	coin.MANTLE:         MANTLE,
+	coin.MANTA:          MANTA,
+	coin.ZETAEVM:        ZETAEVM,
+	coin.MERLIN:         MERLIN,
+	coin.BLAST:          BLAST,
+	coin.SCROLL:         SCROLL,
+	coin.BOUNCEBIT:      BOUNCEBIT,
+	coin.ZKLINKNOVA:     ZKLINKNOVA,
+	coin.SONIC:          SONIC,
+	coin.PLASMA:         PLASMA,
+	coin.MONAD:          MONAD,
+	coin.HYPEREVM:       HYPEREVM,
+	coin.ROBINHOODCHAIN: ROBINHOODCHAIN,
+}
+
+var coinTokenTypes = map[uint][]tokenTypeRule{
+	coin.ETHEREUM: {
+		{Type: ERC20},
+	},
+	coin.CLASSIC: {
+		{Type: ETC20},
+	},
+	coin.COSMOS: {
+		{Type: COSMOS},
+	},
+	coin.RIPPLE: {
+		{Type: XRP},
+	},
+	coin.STELLAR: {
+		{Type: STELLAR},
+	},
+	coin.POA: {
+		{Type: POA20},
+	},
+	coin.TRON: {
+		{Type: TRC10, Numeric: true},
+		{Type: TRC20},
+	},
+	coin.IOTEXEVM: {
+		{Type: IOTEXEVM},
+	},
+	coin.KAVA: {
+		{Type: KAVA},
+	},
+	coin.THETA: {
+		{Type: THETA},
+	},
+	coin.BINANCE: {
+		{Type: BEP2},
+	},
+	coin.VECHAIN: {
+		{Type: VET},
+	},
+	coin.CALLISTO: {
+		{Type: CLO20},
+	},
+	coin.TOMOCHAIN: {
+		{Type: TRC21},
+	},
+	coin.THUNDERTOKEN: {
+		{Type: TT20},
+	},
+	coin.ONTOLOGY: {
+		{Type: ONTOLOGY},
+	},
+	coin.GOCHAIN: {
+		{Type: GO20},
+	},
+	coin.WANCHAIN: {
+		{Type: WAN20},
+	},
+	coin.WAVES: {
+		{Type: WAVES},
+	},
+	coin.BITCOIN: {
+		{Type: BRC20},
+	},
+	coin.ALGORAND: {
+		{Type: ALGORAND},
+	},
+	coin.HARMONY: {
+		{Type: HRC20},
+	},
+	coin.SOLANA: {
+		{Type: SPL},
+	},
+	coin.ELROND: {
+		{Type: ESDT},
+	},
+	coin.SMARTCHAIN: {
+		{Type: BEP20},
+	},
+	coin.OASIS: {
+		{Type: OASIS},
+	},
+	coin.EOS: {
+		{Type: EOS},
+	},
+	coin.TERRA: {
+		{Type: CW20, Length: 44},
+		{Type: TERRA},
+	},
+	coin.NEO: {
+		{Type: NEP5},
+	},
+	coin.CARDANO: {
+		{Type: CARDANO},
+	},
+	coin.NULS: {
+		{Type: NRC20},
+	},
+	coin.POLYGON: {
+		{Type: POLYGON},
+	},
+	coin.OPTIMISM: {
+		{Type: OPTIMISM},
+	},
+	coin.XDAI: {
+		{Type: XDAI},
+	},
+	coin.AVALANCHEC: {
+		{Type: AVALANCHE},
+	},
+	coin.HECO: {
+		{Type: HRC20},
+	},
+	coin.FANTOM: {
+		{Type: FANTOM},
+	},
+	coin.ARBITRUM: {
+		{Type: ARBITRUM},
+	},
+	coin.CELO: {
+		{Type: CELO},
+	},
+	coin.RONIN: {
+		{Type: RONIN},
+	},
+	coin.OSMOSIS: {
+		{Type: OSMOSIS},
+	},
+	coin.CRONOS: {
+		{Type: CRC20},
+	},
+	coin.KCC: {
+		{Type: KRC20},
+	},
+	coin.AURORA: {
+		{Type: AURORA},
+	},
+	coin.KAVAEVM: {
+		{Type: KAVAEVM},
+	},
+	coin.METER: {
+		{Type: METER},
+	},
+	coin.EVMOS: {
+		{Type: EVMOS_ERC20},
+	},
+	coin.NATIVEEVMOS: {
+		{Type: NATIVEEVMOS},
+	},
+	coin.OKC: {
+		{Type: KIP20},
+	},
+	coin.CRYPTOORG: {
+		{Type: CRYPTOORG},
+	},
+	coin.APTOS: {
+		{Type: APTOS, Contains: "::"},
+		{Type: APTOSFA},
+	},
+	coin.MEGAETH: {
+		{Type: MEGAETH},
+	},
+	coin.MOONBEAM: {
+		{Type: MOONBEAM},
+	},
+	coin.KLAYTN: {
+		{Type: KLAYTN},
+	},
+	coin.METIS: {
+		{Type: METIS},
+	},
+	coin.MOONRIVER: {
+		{Type: MOONRIVER},
+	},
+	coin.BOBA: {
+		{Type: BOBA},
+	},
+	coin.TON: {
+		{Type: JETTON},
+	},
+	coin.POLYGONZKEVM: {
+		{Type: POLYGONZKEVM},
+	},
+	coin.ZKSYNC: {
+		{Type: ZKSYNC},
+	},
+	coin.SUI: {
+		{Type: SUI},
+	},
+	coin.STRIDE: {
+		{Type: STRIDE},
+	},
+	coin.NEUTRON: {
+		{Type: NEUTRON},
+	},
+	coin.STARGAZE: {
+		{Type: STARGAZE},
+	},
+	coin.NATIVEINJECTIVE: {
+		{Type: NATIVEINJECTIVE},
+	},
+	coin.CFXEVM: {
+		{Type: CONFLUX},
+	},
+	coin.ACALA: {
+		{Type: ACA},
+	},
+	coin.ACALAEVM: {
+		{Type: ACALAEVM},
+	},
+	coin.BASE: {
+		{Type: BASE},
+	},
+	coin.AKASH: {
+		{Type: AKASH},
+	},
+	coin.AGORIC: {
+		{Type: AGORIC},
+	},
+	coin.AXELAR: {
+		{Type: AXELAR},
+	},
+	coin.JUNO: {
+		{Type: JUNO},
+	},
+	coin.SEI: {
+		{Type: SEI},
+	},
+	coin.SEIEVM: {
+		{Type: SEIEVM},
+	},
+	coin.NEON: {
+		{Type: NEON},
+	},
+	coin.OPBNB: {
+		{Type: OPBNB},
+	},
+	coin.LINEA: {
+		{Type: LINEA},
+	},
+	coin.MANTLE: {
+		{Type: MANTLE},
+	},
+	coin.MANTA: {
+		{Type: MANTA},
+	},
+	coin.ZETACHAIN: {
+		{Type: ZETACHAIN},
+	},
+	coin.ZETAEVM: {
+		{Type: ZETAEVM},
+	},
+	coin.MERLIN: {
+		{Type: MERLIN},
+	},
+	coin.BLAST: {
+		{Type: BLAST},
+	},
+	coin.SCROLL: {
+		{Type: SCROLL},
+	},
+	coin.INTERNET_COMPUTER: {
+		{Type: ICP},
+	},
+	coin.BOUNCEBIT: {
+		{Type: BOUNCEBIT},
+	},
+	coin.ZKLINKNOVA: {
+		{Type: ZKLINKNOVA},
+	},
+	coin.SONIC: {
+		{Type: SONIC},
+	},
+	coin.TIA: {
+		{Type: TIA},
+	},
+	coin.DYDX: {
+		{Type: DYDX},
+	},
+	coin.PLASMA: {
+		{Type: PLASMA},
+	},
+	coin.MONAD: {
+		{Type: MONAD},
+	},
+	coin.HYPEREVM: {
+		{Type: HYPEREVM},
+	},
+	coin.ROBINHOODCHAIN: {
+		{Type: ROBINHOODCHAIN},
+	},
+}
@@ -9,6 +9,7 @@ import (
 
 	mapset "github.com/deckarep/golang-set"
 
+	"github.com/trustwallet/go-primitives/address"
 	"github.com/trustwallet/go-primitives/asset"
 	"github.com/trustwallet/go-primitives/coin"
 )
@@ -252,6 +253,22 @@ func (cc *TransferNFT) GetAsset() coin.AssetID {
 	return cc.Asset
 }
 
+// CollectibleAssetID returns the asset ID of the transferred item, e.g. "c60_t0xcontract_n1234".
+// The collection is taken from the asset token, or from Collection when the asset is the coin.
+func (cc *TransferNFT) CollectibleAssetID() (coin.AssetID, error) {
+	coinID, token, err := asset.ParseID(string(cc.Asset))
+	if err != nil {
+		return "", err
+	}
+	if token == "" {
+		token = cc.Collection
+	}
+	if token == "" || cc.CollectibleID == "" {
+		return "", fmt.Errorf("transfer NFT without collection or collectible ID")
+	}
+	return coin.AssetID(asset.BuildCollectibleID(coinID, token, cc.CollectibleID)), nil
+}
+
 func (cc *TransferNFT) Validate() error {
 	if cc.CollectibleID == "" {
 		return fmt.Errorf("empty transfer NFT collectible ID value")
@@ -345,17 +362,64 @@ func (t *Tx) GetSubscriptionAddresses() ([]string, error) {
 	return result, nil
 }
 
-func (t *Tx) GetDirection(address string) Direction {
+// DirectionOption configures how GetDirection matches addresses.
+type DirectionOption func(*directionOptions)
+
+type directionOptions struct {
+	key func(string) string
+}
+
+// WithAddressEquality makes GetDirection compare addresses with address.Equal for the coin
+// instead of exact string equality, e.g. mixed-case hex or ronin: prefixed addresses match.
+func WithAddressEquality(coinID uint) DirectionOption {
+	return func(o *directionOptions) {
+		o.key = func(addr string) string {
+			return address.EqualityKey(coinID, addr)
+		}
+	}
+}
+
+func (t *Tx) GetDirection(address string, opts ...DirectionOption) Direction {
 	if len(t.Direction) > 0 {
 		return t.Direction
 	}
 
-	if len(t.Inputs) > 0 && len(t.Outputs) > 0 {
+	var options directionOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	tx := t
+	if options.key != nil {
+		tx = t.withAddressKeys(options.key)
+		address = options.key(address)
+	}
+
+	if len(tx.Inputs) > 0 && len(tx.Outputs) > 0 {
 		addressSet := mapset.NewSet(address)
-		return InferDirection(t, addressSet)
+		return InferDirection(tx, addressSet)
 	}
 
-	return t.determineTransactionDirection(address, t.From, t.To)
+	return tx.determineTransactionDirection(address, tx.From, tx.To)
+}
+
+// withAddressKeys returns a shallow copy of the transaction with every address replaced by its key.
+func (t *Tx) withAddressKeys(key func(string) string) *Tx {
+	tx := *t
+	tx.From = key(t.From)
+	tx.To = key(t.To)
+
+	tx.Inputs = make([]TxOutput, len(t.Inputs))
+	for i, input := range t.Inputs {
+		input.Address = key(input.Address)
+		tx.Inputs[i] = input
+	}
+	tx.Outputs = make([]TxOutput, len(t.Outputs))
+	for i, output := range t.Outputs {
+		output.Address = key(output.Address)
+		tx.Outputs[i] = output
+	}
+	return &tx
 }
 
 func (t *Tx) GetAssetID() *coin.AssetID {
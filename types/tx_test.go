@@ -5,6 +5,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/trustwallet/go-primitives/asset"
+	"github.com/trustwallet/go-primitives/coin"
 )
 
 func TestTxs_CleanMemos(t *testing.T) {
@@ -457,6 +460,150 @@ func TestTx_GetDirection(t *testing.T) {
 
 }
 
+func TestTx_GetDirectionWithAddressEquality(t *testing.T) {
+	tests := []struct {
+		name     string
+		tx       Tx
+		address  string
+		opts     []DirectionOption
+		expected Direction
+	}{
+		{
+			name: "checksummed_address_without_option",
+			tx: Tx{
+				From: "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+				To:   "0xea674fdde714fd979de3edf0f56aa9716b898ec8",
+			},
+			address:  "0xEA674fdDe714fd979de3EdF0F56AA9716B898ec8",
+			expected: DirectionOutgoing,
+		},
+		{
+			name: "checksummed_address_incoming",
+			tx: Tx{
+				From: "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+				To:   "0xea674fdde714fd979de3edf0f56aa9716b898ec8",
+			},
+			address:  "0xEA674fdDe714fd979de3EdF0F56AA9716B898ec8",
+			opts:     []DirectionOption{WithAddressEquality(coin.ETHEREUM)},
+			expected: DirectionIncoming,
+		},
+		{
+			name: "ronin_self",
+			tx: Tx{
+				From: "ronin:ea674fdde714fd979de3edf0f56aa9716b898ec8",
+				To:   "0xEA674fdDe714fd979de3EdF0F56AA9716B898ec8",
+			},
+			address:  "0xea674fdde714fd979de3edf0f56aa9716b898ec8",
+			opts:     []DirectionOption{WithAddressEquality(coin.RONIN)},
+			expected: DirectionSelf,
+		},
+		{
+			name: "utxo_segwit_case_outgoing",
+			tx: Tx{
+				Inputs: []TxOutput{
+					{
+						Address: "BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4",
+					},
+				},
+				Outputs: []TxOutput{
+					{
+						Address: "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa",
+					},
+				},
+			},
+			address:  "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
+			opts:     []DirectionOption{WithAddressEquality(coin.BITCOIN)},
+			expected: DirectionOutgoing,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tx := tc.tx
+			result := tx.GetDirection(tc.address, tc.opts...)
+			assert.Equal(t, tc.expected, result)
+			assert.Equal(t, tc.tx, tx)
+		})
+	}
+}
+
+func TestTx_TypedAssetIDs(t *testing.T) {
+	token := asset.NewTokenID(coin.SMARTCHAIN, "0x55d398326f99059fF775485246999027B3197955")
+	tx := Tx{
+		Fee: Fee{Asset: token.Coin().AssetID(), Value: "21000"},
+		Metadata: &Transfer{
+			Asset: token.AssetID(),
+			Value: "1",
+		},
+	}
+
+	assert.Equal(t, coin.Coins[coin.SMARTCHAIN].AssetID(), tx.Fee.Asset)
+	assert.Equal(t, coin.Coins[coin.SMARTCHAIN].TokenAssetID("0x55d398326f99059fF775485246999027B3197955"), *tx.GetAssetID())
+
+	parsed, err := asset.FromAssetID(*tx.GetAssetID())
+	assert.NoError(t, err)
+	assert.Equal(t, token, parsed)
+}
+
+func TestTransferNFT_CollectibleAssetID(t *testing.T) {
+	tests := []struct {
+		name     string
+		transfer TransferNFT
+		expected coin.AssetID
+		wantErr  bool
+	}{
+		{
+			name: "coin_asset_with_collection",
+			transfer: TransferNFT{
+				Asset:         "c60",
+				Collection:    "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d",
+				CollectibleID: "1234",
+			},
+			expected: "c60_t0x06012c8cf97BEaD5deAe237070F9587f8E7A266d_n1234",
+		},
+		{
+			name: "token_asset",
+			transfer: TransferNFT{
+				Asset:         "c137_t0x2953399124F0cBB46d2CbACD8A89cF0599974963",
+				CollectibleID: "42",
+			},
+			expected: "c137_t0x2953399124F0cBB46d2CbACD8A89cF0599974963_n42",
+		},
+		{
+			name: "no_collectible",
+			transfer: TransferNFT{
+				Asset:      "c60",
+				Collection: "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d",
+			},
+			wantErr: true,
+		},
+		{
+			name: "bad_asset",
+			transfer: TransferNFT{
+				Asset:         "60",
+				CollectibleID: "1234",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := tc.transfer.CollectibleAssetID()
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+
+			id, err := asset.ParseCollectible(string(result))
+			assert.NoError(t, err)
+			assert.Equal(t, tc.transfer.CollectibleID, id.CollectibleID())
+		})
+	}
+}
+
 func TestUTXOValueByAddress(t *testing.T) {
 	tests := []struct {
 		name                 string